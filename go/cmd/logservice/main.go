@@ -3,17 +3,26 @@ package main
 import (
 	"context"
 	"github.com/chroma-core/chroma/go/pkg/log/configuration"
+	"github.com/chroma-core/chroma/go/pkg/log/purger"
 	"github.com/chroma-core/chroma/go/pkg/log/repository"
 	"github.com/chroma-core/chroma/go/pkg/log/server"
+	"github.com/chroma-core/chroma/go/pkg/log/sysdb"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/utils"
 	libs "github.com/chroma-core/chroma/go/shared/libs"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pingcap/log"
 	"github.com/rs/zerolog"
 	"go.uber.org/automaxprocs/maxprocs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -26,12 +35,74 @@ func main() {
 	}
 	log.Info("Starting log service")
 	config := configuration.NewLogServiceConfiguration()
-	conn, err := libs.NewPgConnection(ctx, config)
-	if err != nil {
-		log.Fatal("failed to connect to postgres", zap.Error(err))
+	quota := repository.Quota{
+		MaxCollectionRecords: config.MAX_UNCOMPACTED_RECORDS_PER_COLLECTION,
+		MaxCollectionBytes:   config.MAX_UNCOMPACTED_BYTES_PER_COLLECTION,
+		MaxTenantRecords:     config.MAX_UNCOMPACTED_RECORDS_PER_TENANT,
+		MaxTenantBytes:       config.MAX_UNCOMPACTED_BYTES_PER_TENANT,
+	}
+	var lr repository.LogStore
+	var err error
+	switch config.LOG_STORE {
+	case "postgres":
+		var pool *pgxpool.Pool
+		pool, err = libs.NewPgPool(ctx, config)
+		if err != nil {
+			log.Fatal("failed to connect to postgres", zap.Error(err))
+		}
+		defer pool.Close()
+		lr = repository.NewLogRepository(pool, config.IDEMPOTENCY_WINDOW, config.RESTORE_WINDOW, quota)
+	case "file":
+		var fileStore *repository.FileLogStore
+		fileStore, err = repository.NewFileLogStore(repository.FileLogStoreConfig{
+			Dir:           config.LOG_DIR,
+			FsyncPolicy:   repository.FsyncPolicy(config.FSYNC_POLICY),
+			FsyncInterval: config.FSYNC_INTERVAL,
+			SegmentSize:   config.SEGMENT_SIZE,
+		}, config.IDEMPOTENCY_WINDOW, config.RESTORE_WINDOW, quota)
+		if err != nil {
+			log.Fatal("failed to open the log files", zap.Error(err))
+		}
+		defer func() {
+			if err := fileStore.Close(); err != nil {
+				log.Error("failed to close the log files", zap.Error(err))
+			}
+		}()
+		lr = fileStore
+	case "memory":
+		log.Warn("the logs are kept in memory and are lost when the log service stops")
+		lr = repository.NewMemoryLogStore(config.IDEMPOTENCY_WINDOW, config.RESTORE_WINDOW, quota)
+	default:
+		log.Fatal("unknown log store", zap.String("logStore", config.LOG_STORE))
+	}
+	if config.PURGE_INTERVAL > 0 {
+		var logPurger *purger.Purger
+		logPurger, err = purger.NewPurger(lr, purger.Config{
+			Interval:         config.PURGE_INTERVAL,
+			BatchSize:        config.PURGE_BATCH_SIZE,
+			MaxBatchesPerRun: config.PURGE_MAX_BATCHES,
+			DefaultRetention: repository.Retention{
+				PeriodSeconds: config.RETENTION_PERIOD_SECONDS,
+				RecordCount:   config.RETENTION_RECORD_COUNT,
+			},
+		})
+		if err != nil {
+			log.Fatal("failed to create log purger", zap.Error(err))
+		}
+		logPurger.Start()
+		defer logPurger.Stop()
+	}
+	var sysDB *sysdb.CachedSysDB
+	if config.SYSDB_ADDRESS != "" {
+		var sysDBConn *grpc.ClientConn
+		sysDBConn, err = grpc.Dial(config.SYSDB_ADDRESS, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatal("failed to connect to sysdb", zap.Error(err))
+		}
+		defer sysDBConn.Close()
+		sysDB = sysdb.NewCachedSysDB(coordinatorpb.NewSysDBClient(sysDBConn), config.SYSDB_CACHE_TTL)
 	}
-	lr := repository.NewLogRepository(conn)
-	server := server.NewLogServer(lr)
+	server := server.NewLogServer(lr, config.QUOTA_RETRY_AFTER, sysDB)
 	var listener net.Listener
 	listener, err = net.Listen("tcp", ":"+config.PORT)
 	if err != nil {
@@ -39,6 +110,23 @@ func main() {
 	}
 	s := grpc.NewServer()
 	logservicepb.RegisterLogServiceServer(s, server)
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		<-signals
+		log.Info("stopping log service")
+		// TailLogs streams only end when their client goes away
+		stopped := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(10 * time.Second):
+			s.Stop()
+		}
+	}()
 	log.Info("log service started", zap.String("address", listener.Addr().String()))
 	if err := s.Serve(listener); err != nil {
 		log.Fatal("failed to serve", zap.Error(err))
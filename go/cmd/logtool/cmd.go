@@ -0,0 +1,220 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/chroma-core/chroma/go/pkg/log/export"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxImportBatchSize is the largest batch of records the log service accepts
+const maxImportBatchSize = 1000
+
+var (
+	exportConf struct {
+		collectionID   string
+		format         string
+		output         string
+		startOffset    int64
+		endOffset      int64
+		startTimestamp int64
+		endTimestamp   int64
+	}
+	importConf struct {
+		collectionID string
+		format       string
+		input        string
+		batchSize    int
+		importID     string
+	}
+
+	exportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "Export the log of a collection",
+		Long:  `Export the records of a collection within offset and timestamp bounds, with their offsets and timestamps.`,
+		Args:  cobra.NoArgs,
+		RunE:  runExport,
+	}
+	importCmd = &cobra.Command{
+		Use:   "import",
+		Short: "Import an exported log into a collection",
+		Long:  `Append the records of an exported log to the log of a collection, they get the next offsets of the collection.`,
+		Args:  cobra.NoArgs,
+		RunE:  runImport,
+	}
+)
+
+func init() {
+	exportCmd.Flags().StringVar(&exportConf.collectionID, "collection", "", "Collection to export")
+	exportCmd.Flags().StringVar(&exportConf.format, "format", string(export.FormatProtobuf), "File format, protobuf or ndjson")
+	exportCmd.Flags().StringVarP(&exportConf.output, "output", "o", "", "Output file, stdout by default")
+	exportCmd.Flags().Int64Var(&exportConf.startOffset, "start-offset", 0, "First offset to export")
+	exportCmd.Flags().Int64Var(&exportConf.endOffset, "end-offset", 0, "Last offset to export")
+	exportCmd.Flags().Int64Var(&exportConf.startTimestamp, "start-timestamp", 0, "Export the records written from this time, in nanoseconds since the epoch")
+	exportCmd.Flags().Int64Var(&exportConf.endTimestamp, "end-timestamp", 0, "Export the records written up to this time, in nanoseconds since the epoch")
+	_ = exportCmd.MarkFlagRequired("collection")
+
+	importCmd.Flags().StringVar(&importConf.collectionID, "collection", "", "Collection to import into")
+	importCmd.Flags().StringVar(&importConf.format, "format", string(export.FormatProtobuf), "File format, protobuf or ndjson")
+	importCmd.Flags().StringVarP(&importConf.input, "input", "i", "", "Input file, stdin by default")
+	importCmd.Flags().IntVar(&importConf.batchSize, "batch-size", 100, "Records pushed at a time")
+	importCmd.Flags().StringVar(&importConf.importID, "import-id", "", "Id of the import, retrying an import with the same id and batch size skips the batches already imported. Generated by default")
+	_ = importCmd.MarkFlagRequired("collection")
+}
+
+func newLogServiceClient() (logservicepb.LogServiceClient, func() error, error) {
+	conn, err := grpc.Dial(logServiceAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return logservicepb.NewLogServiceClient(conn), conn.Close, nil
+}
+
+func runExport(cmd *cobra.Command, _ []string) (err error) {
+	format, err := export.ParseFormat(exportConf.format)
+	if err != nil {
+		return err
+	}
+	req := &logservicepb.ExportCollectionLogRequest{CollectionId: exportConf.collectionID}
+	if cmd.Flags().Changed("start-offset") {
+		req.StartOffset = &exportConf.startOffset
+	}
+	if cmd.Flags().Changed("end-offset") {
+		req.EndOffset = &exportConf.endOffset
+	}
+	if cmd.Flags().Changed("start-timestamp") {
+		req.StartTimestamp = &exportConf.startTimestamp
+	}
+	if cmd.Flags().Changed("end-timestamp") {
+		req.EndTimestamp = &exportConf.endTimestamp
+	}
+
+	output := io.Writer(os.Stdout)
+	if exportConf.output != "" {
+		file, err := os.Create(exportConf.output)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, file.Close())
+		}()
+		output = file
+	}
+	client, closeClient, err := newLogServiceClient()
+	if err != nil {
+		return err
+	}
+	defer closeClient()
+
+	stream, err := client.ExportCollectionLog(cmd.Context(), req)
+	if err != nil {
+		return err
+	}
+	writer := export.NewWriter(output, format)
+	count := 0
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, record := range res.Records {
+			if err = writer.Write(record); err != nil {
+				return err
+			}
+		}
+		count += len(res.Records)
+	}
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "Exported %d records of collection %s\n", count, exportConf.collectionID)
+	return nil
+}
+
+func runImport(cmd *cobra.Command, _ []string) error {
+	format, err := export.ParseFormat(importConf.format)
+	if err != nil {
+		return err
+	}
+	if importConf.batchSize < 1 || importConf.batchSize > maxImportBatchSize {
+		return fmt.Errorf("batch size must be between 1 and %d", maxImportBatchSize)
+	}
+	importID := importConf.importID
+	if importID == "" {
+		importID = uuid.NewString()
+	}
+
+	input := io.Reader(os.Stdin)
+	if importConf.input != "" {
+		file, err := os.Open(importConf.input)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+	client, closeClient, err := newLogServiceClient()
+	if err != nil {
+		return err
+	}
+	defer closeClient()
+
+	ctx := cmd.Context()
+	reader := export.NewReader(input, format)
+	var records []*coordinatorpb.OperationRecord
+	// read is the number of records read before the batch
+	read, count := 0, 0
+	// push sends a batch with a key derived from the import id and the records of
+	// the file in the batch, so that retrying an interrupted import with the same id
+	// and batch size within the idempotency window of the log service does not
+	// duplicate the batches already imported
+	push := func() error {
+		first, last := read+1, read+len(records)
+		res, err := client.ImportCollectionLog(ctx, &logservicepb.ImportCollectionLogRequest{
+			CollectionId:   importConf.collectionID,
+			Records:        records,
+			IdempotencyKey: proto.String(fmt.Sprintf("%s-%d-%d", importID, first, last)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to import records %d to %d: %w", first, last, err)
+		}
+		read = last
+		count += int(res.RecordCount)
+		records = records[:0]
+		return nil
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read record %d: %w", read+len(records)+1, err)
+		}
+		records = append(records, record.Record)
+		if len(records) == importConf.batchSize {
+			if err = push(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(records) > 0 {
+		if err = push(); err != nil {
+			return err
+		}
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "Imported %d records into collection %s, import id %s\n", count, importConf.collectionID, importID)
+	return nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	logServiceAddress string
+
+	rootCmd = &cobra.Command{
+		Use:   "logtool",
+		Short: "Export and import the log of a collection",
+		Long:  `Export the log of a collection to a file and import it into another collection, to replay the records a compactor saw.`,
+	}
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&logServiceAddress, "log-service-address", "localhost:50051", "Log service address")
+	rootCmd.AddCommand(exportCmd, importCmd)
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -33,6 +33,7 @@ func (r iteratorForInsertRecord) Values() ([]interface{}, error) {
 		r.rows[0].Offset,
 		r.rows[0].Record,
 		r.rows[0].Timestamp,
+		r.rows[0].Checksum,
 	}, nil
 }
 
@@ -41,5 +42,5 @@ func (r iteratorForInsertRecord) Err() error {
 }
 
 func (q *Queries) InsertRecord(ctx context.Context, arg []InsertRecordParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"record_log"}, []string{"collection_id", "offset", "record", "timestamp"}, &iteratorForInsertRecord{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"record_log"}, []string{"collection_id", "offset", "record", "timestamp", "checksum"}, &iteratorForInsertRecord{rows: arg})
 }
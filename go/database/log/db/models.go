@@ -4,12 +4,37 @@
 
 package log
 
-import ()
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
 
 type Collection struct {
 	ID                              string
 	RecordCompactionOffsetPosition  int64
 	RecordEnumerationOffsetPosition int64
+	RetentionPeriodSeconds          pgtype.Int8
+	RetentionRecordCount            pgtype.Int8
+	TenantID                        pgtype.Text
+	UncompactedBytes                int64
+}
+
+type CollectionCompactionOffset struct {
+	CollectionID   string
+	OffsetPosition int64
+	Timestamp      int64
+}
+
+type CollectionTombstone struct {
+	CollectionID string
+	DeletedAt    int64
+}
+
+type PushIdempotencyKey struct {
+	CollectionID   string
+	IdempotencyKey string
+	FirstOffset    int64
+	RecordCount    int64
+	Timestamp      int64
 }
 
 type RecordLog struct {
@@ -17,4 +42,5 @@ type RecordLog struct {
 	CollectionID string
 	Timestamp    int64
 	Record       []byte
+	Checksum     pgtype.Int8
 }
@@ -7,41 +7,180 @@ package log
 
 import (
 	"context"
-)
 
-const getAllCollectionsToCompact = `-- name: GetAllCollectionsToCompact :many
-with summary as (
-    select r.collection_id, r.offset, r.timestamp, row_number() over(partition by r.collection_id order by r.offset) as rank
-    from record_log r, collection c
-    where r.collection_id = c.id
-    and r.offset > c.record_compaction_offset_position
+	"github.com/jackc/pgx/v5/pgtype"
 )
-select collection_id, "offset", timestamp, rank from summary
-where rank=1
-order by timestamp
+
+const deleteCollection = `-- name: DeleteCollection :exec
+DELETE FROM collection WHERE id = $1
+`
+
+func (q *Queries) DeleteCollection(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteCollection, id)
+	return err
+}
+
+const deleteCompactionOffsetsForCollection = `-- name: DeleteCompactionOffsetsForCollection :exec
+DELETE FROM collection_compaction_offset WHERE collection_id = $1
+`
+
+func (q *Queries) DeleteCompactionOffsetsForCollection(ctx context.Context, collectionID string) error {
+	_, err := q.db.Exec(ctx, deleteCompactionOffsetsForCollection, collectionID)
+	return err
+}
+
+const deleteExpiredCompactionOffsets = `-- name: DeleteExpiredCompactionOffsets :exec
+DELETE FROM collection_compaction_offset WHERE collection_id = $1 AND timestamp < $2
 `
 
-type GetAllCollectionsToCompactRow struct {
+type DeleteExpiredCompactionOffsetsParams struct {
+	CollectionID string
+	Timestamp    int64
+}
+
+func (q *Queries) DeleteExpiredCompactionOffsets(ctx context.Context, arg DeleteExpiredCompactionOffsetsParams) error {
+	_, err := q.db.Exec(ctx, deleteExpiredCompactionOffsets, arg.CollectionID, arg.Timestamp)
+	return err
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM push_idempotency_key WHERE collection_id = $1 AND timestamp < $2
+`
+
+type DeleteExpiredIdempotencyKeysParams struct {
 	CollectionID string
-	Offset       int64
 	Timestamp    int64
-	Rank         int64
 }
 
-func (q *Queries) GetAllCollectionsToCompact(ctx context.Context) ([]GetAllCollectionsToCompactRow, error) {
-	rows, err := q.db.Query(ctx, getAllCollectionsToCompact)
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context, arg DeleteExpiredIdempotencyKeysParams) error {
+	_, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys, arg.CollectionID, arg.Timestamp)
+	return err
+}
+
+const deleteIdempotencyKeysForCollection = `-- name: DeleteIdempotencyKeysForCollection :exec
+DELETE FROM push_idempotency_key WHERE collection_id = $1
+`
+
+func (q *Queries) DeleteIdempotencyKeysForCollection(ctx context.Context, collectionID string) error {
+	_, err := q.db.Exec(ctx, deleteIdempotencyKeysForCollection, collectionID)
+	return err
+}
+
+const deleteRecordsForCollection = `-- name: DeleteRecordsForCollection :execrows
+DELETE FROM record_log WHERE collection_id = $1
+`
+
+func (q *Queries) DeleteRecordsForCollection(ctx context.Context, collectionID string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRecordsForCollection, collectionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCollection = `-- name: GetCollection :one
+SELECT id, record_compaction_offset_position, record_enumeration_offset_position, retention_period_seconds, retention_record_count, tenant_id, uncompacted_bytes
+FROM collection
+WHERE id = $1
+`
+
+func (q *Queries) GetCollection(ctx context.Context, id string) (Collection, error) {
+	row := q.db.QueryRow(ctx, getCollection, id)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.RecordCompactionOffsetPosition,
+		&i.RecordEnumerationOffsetPosition,
+		&i.RetentionPeriodSeconds,
+		&i.RetentionRecordCount,
+		&i.TenantID,
+		&i.UncompactedBytes,
+	)
+	return i, err
+}
+
+const getCollectionForUpdate = `-- name: GetCollectionForUpdate :one
+SELECT id, record_compaction_offset_position, record_enumeration_offset_position, retention_period_seconds, retention_record_count, tenant_id, uncompacted_bytes
+FROM collection
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCollectionForUpdate(ctx context.Context, id string) (Collection, error) {
+	row := q.db.QueryRow(ctx, getCollectionForUpdate, id)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.RecordCompactionOffsetPosition,
+		&i.RecordEnumerationOffsetPosition,
+		&i.RetentionPeriodSeconds,
+		&i.RetentionRecordCount,
+		&i.TenantID,
+		&i.UncompactedBytes,
+	)
+	return i, err
+}
+
+const getCollectionsToCompact = `-- name: GetCollectionsToCompact :many
+SELECT c.id AS collection_id, c.tenant_id, r.offset AS first_offset, r.timestamp AS first_timestamp,
+c.record_enumeration_offset_position AS latest_offset,
+(c.record_enumeration_offset_position - c.record_compaction_offset_position)::bigint AS pending_records,
+c.uncompacted_bytes AS pending_bytes
+FROM collection c
+CROSS JOIN LATERAL (
+    SELECT r.offset, r.timestamp
+    FROM record_log r
+    WHERE r.collection_id = c.id
+    AND r.offset > c.record_compaction_offset_position
+    ORDER BY r.offset
+    LIMIT 1
+) r
+WHERE c.record_enumeration_offset_position - c.record_compaction_offset_position >= $1::bigint
+AND r.timestamp <= $2::bigint
+AND ($3::text IS NULL OR c.tenant_id = $3::text)
+ORDER BY r.timestamp
+LIMIT $4::int
+`
+
+type GetCollectionsToCompactParams struct {
+	MinPendingRecords int64
+	MaxFirstTimestamp int64
+	TenantID          pgtype.Text
+	MaxCount          pgtype.Int4
+}
+
+type GetCollectionsToCompactRow struct {
+	CollectionID   string
+	TenantID       pgtype.Text
+	FirstOffset    int64
+	FirstTimestamp int64
+	LatestOffset   int64
+	PendingRecords int64
+	PendingBytes   int64
+}
+
+func (q *Queries) GetCollectionsToCompact(ctx context.Context, arg GetCollectionsToCompactParams) ([]GetCollectionsToCompactRow, error) {
+	rows, err := q.db.Query(ctx, getCollectionsToCompact,
+		arg.MinPendingRecords,
+		arg.MaxFirstTimestamp,
+		arg.TenantID,
+		arg.MaxCount,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAllCollectionsToCompactRow
+	var items []GetCollectionsToCompactRow
 	for rows.Next() {
-		var i GetAllCollectionsToCompactRow
+		var i GetCollectionsToCompactRow
 		if err := rows.Scan(
 			&i.CollectionID,
-			&i.Offset,
-			&i.Timestamp,
-			&i.Rank,
+			&i.TenantID,
+			&i.FirstOffset,
+			&i.FirstTimestamp,
+			&i.LatestOffset,
+			&i.PendingRecords,
+			&i.PendingBytes,
 		); err != nil {
 			return nil, err
 		}
@@ -53,22 +192,42 @@ func (q *Queries) GetAllCollectionsToCompact(ctx context.Context) ([]GetAllColle
 	return items, nil
 }
 
-const getCollectionForUpdate = `-- name: GetCollectionForUpdate :one
-SELECT id, record_compaction_offset_position, record_enumeration_offset_position
-FROM collection
-WHERE id = $1
-FOR UPDATE
+const getFirstRecordOffset = `-- name: GetFirstRecordOffset :one
+SELECT COALESCE(min("offset"), 1)::bigint FROM record_log WHERE collection_id = $1
 `
 
-func (q *Queries) GetCollectionForUpdate(ctx context.Context, id string) (Collection, error) {
-	row := q.db.QueryRow(ctx, getCollectionForUpdate, id)
-	var i Collection
-	err := row.Scan(&i.ID, &i.RecordCompactionOffsetPosition, &i.RecordEnumerationOffsetPosition)
+func (q *Queries) GetFirstRecordOffset(ctx context.Context, collectionID string) (int64, error) {
+	row := q.db.QueryRow(ctx, getFirstRecordOffset, collectionID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT collection_id, idempotency_key, first_offset, record_count, timestamp FROM push_idempotency_key WHERE collection_id = $1 AND idempotency_key = $2 AND timestamp >= $3
+`
+
+type GetIdempotencyKeyParams struct {
+	CollectionID   string
+	IdempotencyKey string
+	Timestamp      int64
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (PushIdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.CollectionID, arg.IdempotencyKey, arg.Timestamp)
+	var i PushIdempotencyKey
+	err := row.Scan(
+		&i.CollectionID,
+		&i.IdempotencyKey,
+		&i.FirstOffset,
+		&i.RecordCount,
+		&i.Timestamp,
+	)
 	return i, err
 }
 
 const getRecordsForCollection = `-- name: GetRecordsForCollection :many
-SELECT "offset", collection_id, timestamp, record FROM record_log r WHERE r.collection_id = $1 AND r.offset >= $2 and r.timestamp <= $4  ORDER BY r.offset ASC limit $3
+SELECT "offset", collection_id, timestamp, record, checksum FROM record_log r WHERE r.collection_id = $1 AND r.offset >= $2 and r.timestamp <= $4  ORDER BY r.offset ASC limit $3
 `
 
 type GetRecordsForCollectionParams struct {
@@ -97,6 +256,7 @@ func (q *Queries) GetRecordsForCollection(ctx context.Context, arg GetRecordsFor
 			&i.CollectionID,
 			&i.Timestamp,
 			&i.Record,
+			&i.Checksum,
 		); err != nil {
 			return nil, err
 		}
@@ -108,41 +268,216 @@ func (q *Queries) GetRecordsForCollection(ctx context.Context, arg GetRecordsFor
 	return items, nil
 }
 
+const getTenantUncompactedUsage = `-- name: GetTenantUncompactedUsage :one
+SELECT COALESCE(sum(record_enumeration_offset_position - record_compaction_offset_position), 0)::bigint AS records, COALESCE(sum(uncompacted_bytes), 0)::bigint AS bytes
+FROM collection
+WHERE tenant_id = $1
+`
+
+type GetTenantUncompactedUsageRow struct {
+	Records int64
+	Bytes   int64
+}
+
+func (q *Queries) GetTenantUncompactedUsage(ctx context.Context, tenantID pgtype.Text) (GetTenantUncompactedUsageRow, error) {
+	row := q.db.QueryRow(ctx, getTenantUncompactedUsage, tenantID)
+	var i GetTenantUncompactedUsageRow
+	err := row.Scan(&i.Records, &i.Bytes)
+	return i, err
+}
+
 const insertCollection = `-- name: InsertCollection :one
-INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position) values($1, $2, $3) returning id, record_compaction_offset_position, record_enumeration_offset_position
+INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position, tenant_id) values($1, $2, $3, $4) returning id, record_compaction_offset_position, record_enumeration_offset_position, retention_period_seconds, retention_record_count, tenant_id, uncompacted_bytes
 `
 
 type InsertCollectionParams struct {
 	ID                              string
 	RecordEnumerationOffsetPosition int64
 	RecordCompactionOffsetPosition  int64
+	TenantID                        pgtype.Text
 }
 
 func (q *Queries) InsertCollection(ctx context.Context, arg InsertCollectionParams) (Collection, error) {
-	row := q.db.QueryRow(ctx, insertCollection, arg.ID, arg.RecordEnumerationOffsetPosition, arg.RecordCompactionOffsetPosition)
+	row := q.db.QueryRow(ctx, insertCollection,
+		arg.ID,
+		arg.RecordEnumerationOffsetPosition,
+		arg.RecordCompactionOffsetPosition,
+		arg.TenantID,
+	)
 	var i Collection
-	err := row.Scan(&i.ID, &i.RecordCompactionOffsetPosition, &i.RecordEnumerationOffsetPosition)
+	err := row.Scan(
+		&i.ID,
+		&i.RecordCompactionOffsetPosition,
+		&i.RecordEnumerationOffsetPosition,
+		&i.RetentionPeriodSeconds,
+		&i.RetentionRecordCount,
+		&i.TenantID,
+		&i.UncompactedBytes,
+	)
 	return i, err
 }
 
+const insertCollectionTombstone = `-- name: InsertCollectionTombstone :exec
+INSERT INTO collection_tombstone (collection_id, deleted_at) values($1, $2) ON CONFLICT (collection_id) DO NOTHING
+`
+
+type InsertCollectionTombstoneParams struct {
+	CollectionID string
+	DeletedAt    int64
+}
+
+func (q *Queries) InsertCollectionTombstone(ctx context.Context, arg InsertCollectionTombstoneParams) error {
+	_, err := q.db.Exec(ctx, insertCollectionTombstone, arg.CollectionID, arg.DeletedAt)
+	return err
+}
+
+const insertCompactionOffset = `-- name: InsertCompactionOffset :exec
+INSERT INTO collection_compaction_offset (collection_id, offset_position, timestamp) values($1, $2, $3)
+ON CONFLICT (collection_id, timestamp) DO UPDATE SET offset_position = LEAST(collection_compaction_offset.offset_position, excluded.offset_position)
+`
+
+type InsertCompactionOffsetParams struct {
+	CollectionID   string
+	OffsetPosition int64
+	Timestamp      int64
+}
+
+func (q *Queries) InsertCompactionOffset(ctx context.Context, arg InsertCompactionOffsetParams) error {
+	_, err := q.db.Exec(ctx, insertCompactionOffset, arg.CollectionID, arg.OffsetPosition, arg.Timestamp)
+	return err
+}
+
+const insertIdempotencyKey = `-- name: InsertIdempotencyKey :exec
+INSERT INTO push_idempotency_key (collection_id, idempotency_key, first_offset, record_count, timestamp) values($1, $2, $3, $4, $5)
+ON CONFLICT (collection_id, idempotency_key) DO UPDATE SET first_offset = excluded.first_offset, record_count = excluded.record_count, timestamp = excluded.timestamp
+`
+
+type InsertIdempotencyKeyParams struct {
+	CollectionID   string
+	IdempotencyKey string
+	FirstOffset    int64
+	RecordCount    int64
+	Timestamp      int64
+}
+
+func (q *Queries) InsertIdempotencyKey(ctx context.Context, arg InsertIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, insertIdempotencyKey,
+		arg.CollectionID,
+		arg.IdempotencyKey,
+		arg.FirstOffset,
+		arg.RecordCount,
+		arg.Timestamp,
+	)
+	return err
+}
+
 type InsertRecordParams struct {
 	CollectionID string
 	Offset       int64
 	Record       []byte
 	Timestamp    int64
+	Checksum     pgtype.Int8
+}
+
+const isCollectionTombstoned = `-- name: IsCollectionTombstoned :one
+SELECT EXISTS(SELECT 1 FROM collection_tombstone WHERE collection_id = $1)
+`
+
+func (q *Queries) IsCollectionTombstoned(ctx context.Context, collectionID string) (bool, error) {
+	row := q.db.QueryRow(ctx, isCollectionTombstoned, collectionID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const lockTenantUsage = `-- name: LockTenantUsage :exec
+SELECT pg_advisory_xact_lock(hashtext($1::text))
+`
+
+func (q *Queries) LockTenantUsage(ctx context.Context, tenantID string) error {
+	_, err := q.db.Exec(ctx, lockTenantUsage, tenantID)
+	return err
+}
+
+const purgeIdempotencyKeysBatch = `-- name: PurgeIdempotencyKeysBatch :execrows
+DELETE FROM push_idempotency_key
+WHERE (collection_id, idempotency_key) IN (
+    SELECT collection_id, idempotency_key
+    FROM push_idempotency_key
+    WHERE timestamp < $1::bigint
+    LIMIT $2::int
+)
+`
+
+type PurgeIdempotencyKeysBatchParams struct {
+	Timestamp int64
+	BatchSize int32
+}
+
+func (q *Queries) PurgeIdempotencyKeysBatch(ctx context.Context, arg PurgeIdempotencyKeysBatchParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeIdempotencyKeysBatch, arg.Timestamp, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const purgeRecords = `-- name: PurgeRecords :exec
 DELETE FROM record_log r using collection c where r.collection_id = c.id and r.offset < c.record_compaction_offset_position
+AND NOT EXISTS (
+    SELECT 1 FROM collection_compaction_offset h
+    WHERE h.collection_id = c.id AND h.timestamp >= $1::bigint AND h.offset_position < r.offset
+)
 `
 
-func (q *Queries) PurgeRecords(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, purgeRecords)
+func (q *Queries) PurgeRecords(ctx context.Context, restoreWindowStart int64) error {
+	_, err := q.db.Exec(ctx, purgeRecords, restoreWindowStart)
 	return err
 }
 
+const purgeRecordsBatch = `-- name: PurgeRecordsBatch :execrows
+DELETE FROM record_log
+WHERE (collection_id, "offset") IN (
+    SELECT r.collection_id, r.offset
+    FROM record_log r, collection c
+    WHERE r.collection_id = c.id
+    AND r.offset < c.record_compaction_offset_position
+    AND NOT EXISTS (
+        SELECT 1 FROM collection_compaction_offset h
+        WHERE h.collection_id = c.id AND h.timestamp >= $1::bigint AND h.offset_position < r.offset
+    )
+    AND r.timestamp <= $2::bigint - COALESCE(c.retention_period_seconds, $3::bigint) * 1000000000
+    AND r.offset <= c.record_enumeration_offset_position - COALESCE(c.retention_record_count, $4::bigint)
+    LIMIT $5::int
+)
+`
+
+type PurgeRecordsBatchParams struct {
+	RestoreWindowStart            int64
+	Now                           int64
+	DefaultRetentionPeriodSeconds int64
+	DefaultRetentionRecordCount   int64
+	BatchSize                     int32
+}
+
+func (q *Queries) PurgeRecordsBatch(ctx context.Context, arg PurgeRecordsBatchParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeRecordsBatch,
+		arg.RestoreWindowStart,
+		arg.Now,
+		arg.DefaultRetentionPeriodSeconds,
+		arg.DefaultRetentionRecordCount,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateCollectionCompactionOffsetPosition = `-- name: UpdateCollectionCompactionOffsetPosition :exec
-UPDATE collection set record_compaction_offset_position = $2 where id = $1
+UPDATE collection set record_compaction_offset_position = $2,
+uncompacted_bytes = (SELECT COALESCE(sum(octet_length(r.record)), 0) FROM record_log r WHERE r.collection_id = $1 AND r.offset > $2)
+where id = $1
 `
 
 type UpdateCollectionCompactionOffsetPositionParams struct {
@@ -156,15 +491,47 @@ func (q *Queries) UpdateCollectionCompactionOffsetPosition(ctx context.Context,
 }
 
 const updateCollectionEnumerationOffsetPosition = `-- name: UpdateCollectionEnumerationOffsetPosition :exec
-UPDATE collection set record_enumeration_offset_position = $2 where id = $1
+UPDATE collection set record_enumeration_offset_position = $2, uncompacted_bytes = uncompacted_bytes + $3 where id = $1
 `
 
 type UpdateCollectionEnumerationOffsetPositionParams struct {
 	ID                              string
 	RecordEnumerationOffsetPosition int64
+	UncompactedBytes                int64
 }
 
 func (q *Queries) UpdateCollectionEnumerationOffsetPosition(ctx context.Context, arg UpdateCollectionEnumerationOffsetPositionParams) error {
-	_, err := q.db.Exec(ctx, updateCollectionEnumerationOffsetPosition, arg.ID, arg.RecordEnumerationOffsetPosition)
+	_, err := q.db.Exec(ctx, updateCollectionEnumerationOffsetPosition, arg.ID, arg.RecordEnumerationOffsetPosition, arg.UncompactedBytes)
+	return err
+}
+
+const updateCollectionTenant = `-- name: UpdateCollectionTenant :exec
+UPDATE collection set tenant_id = $2 where id = $1
+`
+
+type UpdateCollectionTenantParams struct {
+	ID       string
+	TenantID pgtype.Text
+}
+
+func (q *Queries) UpdateCollectionTenant(ctx context.Context, arg UpdateCollectionTenantParams) error {
+	_, err := q.db.Exec(ctx, updateCollectionTenant, arg.ID, arg.TenantID)
+	return err
+}
+
+const upsertCollectionRetention = `-- name: UpsertCollectionRetention :exec
+INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position, retention_period_seconds, retention_record_count)
+values($1, 0, 0, $2, $3)
+ON CONFLICT (id) DO UPDATE SET retention_period_seconds = excluded.retention_period_seconds, retention_record_count = excluded.retention_record_count
+`
+
+type UpsertCollectionRetentionParams struct {
+	ID                     string
+	RetentionPeriodSeconds pgtype.Int8
+	RetentionRecordCount   pgtype.Int8
+}
+
+func (q *Queries) UpsertCollectionRetention(ctx context.Context, arg UpsertCollectionRetentionParams) error {
+	_, err := q.db.Exec(ctx, upsertCollectionRetention, arg.ID, arg.RetentionPeriodSeconds, arg.RetentionRecordCount)
 	return err
 }
@@ -0,0 +1,2 @@
+-- Modify "collection" table
+ALTER TABLE "public"."collection" ADD COLUMN "retention_period_seconds" bigint NULL, ADD COLUMN "retention_record_count" bigint NULL;
@@ -0,0 +1,9 @@
+-- Create "push_idempotency_key" table
+CREATE TABLE "public"."push_idempotency_key" (
+  "collection_id" text NOT NULL,
+  "idempotency_key" text NOT NULL,
+  "first_offset" bigint NOT NULL,
+  "record_count" bigint NOT NULL,
+  "timestamp" bigint NOT NULL,
+  PRIMARY KEY ("collection_id", "idempotency_key")
+);
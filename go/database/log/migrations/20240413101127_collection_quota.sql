@@ -0,0 +1,4 @@
+-- Modify "collection" table
+ALTER TABLE "public"."collection" ADD COLUMN "tenant_id" text NULL, ADD COLUMN "uncompacted_bytes" bigint NOT NULL DEFAULT 0;
+-- Create index "collection_tenant_id" to table: "collection"
+CREATE INDEX "collection_tenant_id" ON "public"."collection" ("tenant_id");
@@ -0,0 +1,6 @@
+-- Create "collection_tombstone" table
+CREATE TABLE "public"."collection_tombstone" (
+  "collection_id" text NOT NULL,
+  "deleted_at" bigint NOT NULL,
+  PRIMARY KEY ("collection_id")
+);
@@ -0,0 +1,2 @@
+-- Modify "record_log" table
+ALTER TABLE "public"."record_log" ADD COLUMN "checksum" bigint NULL;
@@ -0,0 +1,4 @@
+-- Modify "collection" table
+ALTER TABLE "public"."collection" ADD COLUMN "min_compaction_offset_position" bigint NULL;
+-- The earlier compaction offsets are not known, keep the records that are left
+UPDATE "public"."collection" c SET "min_compaction_offset_position" = LEAST(c.record_compaction_offset_position, COALESCE((SELECT min(r.offset) FROM "public"."record_log" r WHERE r.collection_id = c.id), c.record_compaction_offset_position + 1) - 1) WHERE c.record_compaction_offset_position > 0;
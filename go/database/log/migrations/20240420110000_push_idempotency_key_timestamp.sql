@@ -0,0 +1,2 @@
+-- Create index "push_idempotency_key_timestamp" to table: "push_idempotency_key"
+CREATE INDEX "push_idempotency_key_timestamp" ON "public"."push_idempotency_key" ("timestamp");
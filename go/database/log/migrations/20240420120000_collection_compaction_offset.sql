@@ -0,0 +1,12 @@
+-- Create "collection_compaction_offset" table
+CREATE TABLE "public"."collection_compaction_offset" (
+  "collection_id" text NOT NULL,
+  "offset_position" bigint NOT NULL,
+  "timestamp" bigint NOT NULL,
+  PRIMARY KEY ("collection_id", "timestamp")
+);
+-- The versions at the lowest compaction offsets stay restorable for a restore window
+INSERT INTO "public"."collection_compaction_offset" ("collection_id", "offset_position", "timestamp")
+SELECT "id", "min_compaction_offset_position", (extract(epoch from now()) * 1000000000)::bigint FROM "public"."collection" WHERE "min_compaction_offset_position" IS NOT NULL;
+-- Modify "collection" table
+ALTER TABLE "public"."collection" DROP COLUMN "min_compaction_offset_position";
@@ -5,30 +5,129 @@ WHERE id = $1
 FOR UPDATE;
 
 -- name: InsertRecord :copyfrom
-INSERT INTO record_log (collection_id, "offset", record, timestamp) values($1, $2, $3, $4);
+INSERT INTO record_log (collection_id, "offset", record, timestamp, checksum) values($1, $2, $3, $4, $5);
 
 -- name: GetRecordsForCollection :many
 SELECT * FROM record_log r WHERE r.collection_id = $1 AND r.offset >= $2 and r.timestamp <= $4  ORDER BY r.offset ASC limit $3 ;
 
--- name: GetAllCollectionsToCompact :many
-with summary as (
-    select r.collection_id, r.offset, r.timestamp, row_number() over(partition by r.collection_id order by r.offset) as rank
-    from record_log r, collection c
-    where r.collection_id = c.id
-    and r.offset > c.record_compaction_offset_position
-)
-select * from summary
-where rank=1
-order by timestamp;
+-- name: GetCollectionsToCompact :many
+SELECT c.id AS collection_id, c.tenant_id, r.offset AS first_offset, r.timestamp AS first_timestamp,
+c.record_enumeration_offset_position AS latest_offset,
+(c.record_enumeration_offset_position - c.record_compaction_offset_position)::bigint AS pending_records,
+c.uncompacted_bytes AS pending_bytes
+FROM collection c
+CROSS JOIN LATERAL (
+    SELECT r.offset, r.timestamp
+    FROM record_log r
+    WHERE r.collection_id = c.id
+    AND r.offset > c.record_compaction_offset_position
+    ORDER BY r.offset
+    LIMIT 1
+) r
+WHERE c.record_enumeration_offset_position - c.record_compaction_offset_position >= sqlc.arg(min_pending_records)::bigint
+AND r.timestamp <= sqlc.arg(max_first_timestamp)::bigint
+AND (sqlc.narg(tenant_id)::text IS NULL OR c.tenant_id = sqlc.narg(tenant_id)::text)
+ORDER BY r.timestamp
+LIMIT sqlc.narg(max_count)::int;
 
 -- name: UpdateCollectionCompactionOffsetPosition :exec
-UPDATE collection set record_compaction_offset_position = $2 where id = $1;
+UPDATE collection set record_compaction_offset_position = $2,
+uncompacted_bytes = (SELECT COALESCE(sum(octet_length(r.record)), 0) FROM record_log r WHERE r.collection_id = $1 AND r.offset > $2)
+where id = $1;
 
 -- name: UpdateCollectionEnumerationOffsetPosition :exec
-UPDATE collection set record_enumeration_offset_position = $2 where id = $1;
+UPDATE collection set record_enumeration_offset_position = $2, uncompacted_bytes = uncompacted_bytes + $3 where id = $1;
+
+-- name: UpdateCollectionTenant :exec
+UPDATE collection set tenant_id = $2 where id = $1;
+
+-- name: GetTenantUncompactedUsage :one
+SELECT COALESCE(sum(record_enumeration_offset_position - record_compaction_offset_position), 0)::bigint AS records, COALESCE(sum(uncompacted_bytes), 0)::bigint AS bytes
+FROM collection
+WHERE tenant_id = $1;
 
 -- name: InsertCollection :one
-INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position) values($1, $2, $3) returning *;
+INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position, tenant_id) values($1, $2, $3, $4) returning *;
 
 -- name: PurgeRecords :exec
-DELETE FROM record_log r using collection c where r.collection_id = c.id and r.offset < c.record_compaction_offset_position;
+DELETE FROM record_log r using collection c where r.collection_id = c.id and r.offset < c.record_compaction_offset_position
+AND NOT EXISTS (
+    SELECT 1 FROM collection_compaction_offset h
+    WHERE h.collection_id = c.id AND h.timestamp >= sqlc.arg(restore_window_start)::bigint AND h.offset_position < r.offset
+);
+
+-- name: PurgeRecordsBatch :execrows
+DELETE FROM record_log
+WHERE (collection_id, "offset") IN (
+    SELECT r.collection_id, r.offset
+    FROM record_log r, collection c
+    WHERE r.collection_id = c.id
+    AND r.offset < c.record_compaction_offset_position
+    AND NOT EXISTS (
+        SELECT 1 FROM collection_compaction_offset h
+        WHERE h.collection_id = c.id AND h.timestamp >= sqlc.arg(restore_window_start)::bigint AND h.offset_position < r.offset
+    )
+    AND r.timestamp <= sqlc.arg(now)::bigint - COALESCE(c.retention_period_seconds, sqlc.arg(default_retention_period_seconds)::bigint) * 1000000000
+    AND r.offset <= c.record_enumeration_offset_position - COALESCE(c.retention_record_count, sqlc.arg(default_retention_record_count)::bigint)
+    LIMIT sqlc.arg(batch_size)::int
+);
+
+-- name: UpsertCollectionRetention :exec
+INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position, retention_period_seconds, retention_record_count)
+values($1, 0, 0, $2, $3)
+ON CONFLICT (id) DO UPDATE SET retention_period_seconds = excluded.retention_period_seconds, retention_record_count = excluded.retention_record_count;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM push_idempotency_key WHERE collection_id = $1 AND idempotency_key = $2 AND timestamp >= $3;
+
+-- name: InsertIdempotencyKey :exec
+INSERT INTO push_idempotency_key (collection_id, idempotency_key, first_offset, record_count, timestamp) values($1, $2, $3, $4, $5)
+ON CONFLICT (collection_id, idempotency_key) DO UPDATE SET first_offset = excluded.first_offset, record_count = excluded.record_count, timestamp = excluded.timestamp;
+
+-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM push_idempotency_key WHERE collection_id = $1 AND timestamp < $2;
+
+-- name: DeleteCollection :exec
+DELETE FROM collection WHERE id = $1;
+
+-- name: DeleteRecordsForCollection :execrows
+DELETE FROM record_log WHERE collection_id = $1;
+
+-- name: DeleteIdempotencyKeysForCollection :exec
+DELETE FROM push_idempotency_key WHERE collection_id = $1;
+
+-- name: InsertCollectionTombstone :exec
+INSERT INTO collection_tombstone (collection_id, deleted_at) values($1, $2) ON CONFLICT (collection_id) DO NOTHING;
+
+-- name: IsCollectionTombstoned :one
+SELECT EXISTS(SELECT 1 FROM collection_tombstone WHERE collection_id = $1);
+
+-- name: GetCollection :one
+SELECT *
+FROM collection
+WHERE id = $1;
+
+-- name: LockTenantUsage :exec
+SELECT pg_advisory_xact_lock(hashtext(sqlc.arg(tenant_id)::text));
+
+-- name: PurgeIdempotencyKeysBatch :execrows
+DELETE FROM push_idempotency_key
+WHERE (collection_id, idempotency_key) IN (
+    SELECT collection_id, idempotency_key
+    FROM push_idempotency_key
+    WHERE timestamp < sqlc.arg(timestamp)::bigint
+    LIMIT sqlc.arg(batch_size)::int
+);
+
+-- name: InsertCompactionOffset :exec
+INSERT INTO collection_compaction_offset (collection_id, offset_position, timestamp) values($1, $2, $3)
+ON CONFLICT (collection_id, timestamp) DO UPDATE SET offset_position = LEAST(collection_compaction_offset.offset_position, excluded.offset_position);
+
+-- name: DeleteExpiredCompactionOffsets :exec
+DELETE FROM collection_compaction_offset WHERE collection_id = $1 AND timestamp < $2;
+
+-- name: DeleteCompactionOffsetsForCollection :exec
+DELETE FROM collection_compaction_offset WHERE collection_id = $1;
+
+-- name: GetFirstRecordOffset :one
+SELECT COALESCE(min("offset"), 1)::bigint FROM record_log WHERE collection_id = $1;
@@ -1,8 +1,16 @@
 CREATE TABLE collection (
                         id text PRIMARY KEY,
                         record_compaction_offset_position bigint NOT NULL,
-                        record_enumeration_offset_position bigint NOT NULL
+                        record_enumeration_offset_position bigint NOT NULL,
+                        retention_period_seconds bigint,
+                        retention_record_count bigint,
+                        tenant_id text,
+                        uncompacted_bytes bigint NOT NULL DEFAULT 0
                         );
 
+CREATE INDEX collection_tenant_id ON collection (tenant_id);
+
 -- The `record_compaction_offset_position` column indicates the offset position of the latest compaction.
 -- The `record_enenumeration_offset_position` column denotes the incremental offset for the most recent record in a collection.
+-- The `retention_period_seconds` and `retention_record_count` columns keep compacted records around for a while, the purger defaults apply when they are null.
+-- The `uncompacted_bytes` column is the size of the records after `record_compaction_offset_position`, it is what the push quotas of the collection and its tenant are checked against.
@@ -0,0 +1,8 @@
+CREATE TABLE collection_compaction_offset (
+                        collection_id text NOT NULL,
+                        offset_position bigint NOT NULL,
+                        timestamp BIGINT NOT NULL,
+                        PRIMARY KEY(collection_id, timestamp)
+);
+
+-- The compaction offsets of a collection set in the restore window, the sysdb can restore the collection to the log position of the versions they made. The purger keeps the records after the lowest one, older ones are deleted when the compaction offset moves again.
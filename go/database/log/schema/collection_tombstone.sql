@@ -0,0 +1,6 @@
+CREATE TABLE collection_tombstone (
+                        collection_id text PRIMARY KEY,
+                        deleted_at BIGINT NOT NULL
+);
+
+-- The collections deleted from the log, pushes to them are rejected.
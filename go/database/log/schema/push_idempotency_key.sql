@@ -0,0 +1,13 @@
+CREATE TABLE push_idempotency_key (
+                        collection_id text NOT NULL,
+                        idempotency_key text NOT NULL,
+                        first_offset bigint NOT NULL,
+                        record_count bigint NOT NULL,
+                        timestamp BIGINT NOT NULL,
+                        PRIMARY KEY(collection_id, idempotency_key)
+);
+
+CREATE INDEX push_idempotency_key_timestamp ON push_idempotency_key (timestamp);
+
+-- The keys of the recent pushes of a collection, a push retried with the same key returns the records of the first one.
+-- The purger deletes the keys out of the idempotency window of every collection in batches, by `timestamp`.
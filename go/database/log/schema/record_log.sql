@@ -3,5 +3,8 @@ CREATE TABLE record_log (
                         collection_id text NOT NULL,
                         timestamp BIGINT NOT NULL,
                         record bytea NOT NULL,
+                        checksum bigint,
                         PRIMARY KEY(collection_id, "offset")
 );
+
+-- The `checksum` column is the CRC-32C of `record`, it is checked on every read. It is null for the records written before it was added.
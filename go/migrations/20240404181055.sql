@@ -0,0 +1,4 @@
+-- Drop index "uni_collections_name" from table: "collections"
+DROP INDEX "public"."uni_collections_name";
+-- Create index "uni_collections_database_id_name" to table: "collections"
+CREATE UNIQUE INDEX "uni_collections_database_id_name" ON "public"."collections" ("database_id", "name");
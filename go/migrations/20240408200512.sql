@@ -0,0 +1,10 @@
+-- Create "file_references" table
+CREATE TABLE "public"."file_references" (
+  "file_path" text NOT NULL,
+  "segment_id" text NOT NULL,
+  "collection_id" text NULL,
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("file_path", "segment_id")
+);
+-- Create index "idx_file_references_collection_id" to table: "file_references"
+CREATE INDEX "idx_file_references_collection_id" ON "public"."file_references" ("collection_id");
@@ -0,0 +1,9 @@
+-- Create "collection_versions" table
+CREATE TABLE "public"."collection_versions" (
+  "collection_id" text NOT NULL,
+  "version" integer NOT NULL,
+  "log_position" bigint NULL,
+  "segment_file_paths" text NULL DEFAULT '{}',
+  "created_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("collection_id", "version")
+);
@@ -0,0 +1,10 @@
+-- Modify "file_references" table
+ALTER TABLE "public"."file_references" ADD COLUMN "version" integer NOT NULL DEFAULT 0, DROP CONSTRAINT "file_references_pkey", ADD PRIMARY KEY ("file_path", "segment_id", "version");
+-- Reference the files of the existing collection versions
+INSERT INTO "public"."file_references" ("file_path", "segment_id", "collection_id", "version")
+SELECT paths.path, segments.key, v.collection_id, v.version
+FROM "public"."collection_versions" v
+CROSS JOIN LATERAL json_each(v.segment_file_paths::json) AS segments
+CROSS JOIN LATERAL json_each(segments.value) AS files
+CROSS JOIN LATERAL json_array_elements_text(files.value) AS paths(path)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,8 @@
+-- Drop index "idx_tenantid_name" from table: "databases"
+DROP INDEX "public"."idx_tenantid_name";
+-- Create index "idx_tenantid_name" to table: "databases"
+CREATE UNIQUE INDEX "idx_tenantid_name" ON "public"."databases" ("name", "tenant_id") WHERE (is_deleted = false);
+-- Drop index "uni_collections_database_id_name" from table: "collections"
+DROP INDEX "public"."uni_collections_database_id_name";
+-- Create index "uni_collections_database_id_name" to table: "collections"
+CREATE UNIQUE INDEX "uni_collections_database_id_name" ON "public"."collections" ("database_id", "name") WHERE (is_deleted = false);
@@ -0,0 +1,9 @@
+-- Reference the files of the segments written before the file references
+INSERT INTO "public"."file_references" ("file_path", "segment_id", "collection_id", "version")
+SELECT paths.path, s.id, s.collection_id, 0
+FROM "public"."segments" s
+JOIN "public"."collections" c ON c.id = s.collection_id AND c.is_deleted = false
+CROSS JOIN LATERAL json_each(s.file_paths::json) AS files
+CROSS JOIN LATERAL json_array_elements_text(files.value) AS paths(path)
+WHERE s.is_deleted = false
+ON CONFLICT DO NOTHING;
@@ -157,6 +157,24 @@ func (_m *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model
 	return r0
 }
 
+// DeleteDatabase provides a mock function with given fields: ctx, deleteDatabase
+func (_m *Catalog) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error {
+	ret := _m.Called(ctx, deleteDatabase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteDatabase) error); ok {
+		r0 = rf(ctx, deleteDatabase)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteSegment provides a mock function with given fields: ctx, segmentID
 func (_m *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
 	ret := _m.Called(ctx, segmentID)
@@ -175,6 +193,24 @@ func (_m *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID)
 	return r0
 }
 
+// DeleteTenant provides a mock function with given fields: ctx, deleteTenant
+func (_m *Catalog) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	ret := _m.Called(ctx, deleteTenant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTenant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteTenant) error); ok {
+		r0 = rf(ctx, deleteTenant)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FlushCollectionCompaction provides a mock function with given fields: ctx, flushCollectionCompaction
 func (_m *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
 	ret := _m.Called(ctx, flushCollectionCompaction)
@@ -205,6 +241,36 @@ func (_m *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectio
 	return r0, r1
 }
 
+// ForkCollection provides a mock function with given fields: ctx, forkCollection, ts
+func (_m *Catalog) ForkCollection(ctx context.Context, forkCollection *model.ForkCollection, ts int64) (*model.Collection, error) {
+	ret := _m.Called(ctx, forkCollection, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForkCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ForkCollection, int64) (*model.Collection, error)); ok {
+		return rf(ctx, forkCollection, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ForkCollection, int64) *model.Collection); ok {
+		r0 = rf(ctx, forkCollection, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ForkCollection, int64) error); ok {
+		r1 = rf(ctx, forkCollection, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllDatabases provides a mock function with given fields: ctx, ts
 func (_m *Catalog) GetAllDatabases(ctx context.Context, ts int64) ([]*model.Database, error) {
 	ret := _m.Called(ctx, ts)
@@ -265,9 +331,39 @@ func (_m *Catalog) GetAllTenants(ctx context.Context, ts int64) ([]*model.Tenant
 	return r0, r1
 }
 
-// GetCollections provides a mock function with given fields: ctx, collectionID, collectionName, tenantID, databaseName, limit, offset
-func (_m *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	ret := _m.Called(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+// GetCollectionVersions provides a mock function with given fields: ctx, getCollectionVersions
+func (_m *Catalog) GetCollectionVersions(ctx context.Context, getCollectionVersions *model.GetCollectionVersions) ([]*model.CollectionVersion, error) {
+	ret := _m.Called(ctx, getCollectionVersions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionVersions")
+	}
+
+	var r0 []*model.CollectionVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollectionVersions) ([]*model.CollectionVersion, error)); ok {
+		return rf(ctx, getCollectionVersions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollectionVersions) []*model.CollectionVersion); ok {
+		r0 = rf(ctx, getCollectionVersions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetCollectionVersions) error); ok {
+		r1 = rf(ctx, getCollectionVersions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollections provides a mock function with given fields: ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where
+func (_m *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, startAfterID types.UniqueID, where *model.Where) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCollections")
@@ -275,19 +371,19 @@ func (_m *Catalog) GetCollections(ctx context.Context, collectionID types.Unique
 
 	var r0 []*model.Collection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) ([]*model.Collection, error)); ok {
-		return rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, types.UniqueID, *model.Where) ([]*model.Collection, error)); ok {
+		return rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) []*model.Collection); ok {
-		r0 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, types.UniqueID, *model.Where) []*model.Collection); ok {
+		r0 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Collection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) error); ok {
-		r1 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, types.UniqueID, *model.Where) error); ok {
+		r1 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -325,6 +421,36 @@ func (_m *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatab
 	return r0, r1
 }
 
+// GetFileReferenceCounts provides a mock function with given fields: ctx, filePaths
+func (_m *Catalog) GetFileReferenceCounts(ctx context.Context, filePaths []string) (map[string]int64, error) {
+	ret := _m.Called(ctx, filePaths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFileReferenceCounts")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]int64, error)); ok {
+		return rf(ctx, filePaths)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]int64); ok {
+		r0 = rf(ctx, filePaths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, filePaths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSegments provides a mock function with given fields: ctx, segmentID, segmentType, scope, collectionID
 func (_m *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
 	ret := _m.Called(ctx, segmentID, segmentType, scope, collectionID)
@@ -415,6 +541,96 @@ func (_m *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs [
 	return r0, r1
 }
 
+// ListDatabases provides a mock function with given fields: ctx, listDatabases
+func (_m *Catalog) ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error) {
+	ret := _m.Called(ctx, listDatabases)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabases")
+	}
+
+	var r0 []*model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListDatabases) ([]*model.Database, error)); ok {
+		return rf(ctx, listDatabases)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListDatabases) []*model.Database); ok {
+		r0 = rf(ctx, listDatabases)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListDatabases) error); ok {
+		r1 = rf(ctx, listDatabases)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenants provides a mock function with given fields: ctx, listTenants
+func (_m *Catalog) ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error) {
+	ret := _m.Called(ctx, listTenants)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTenants")
+	}
+
+	var r0 []*model.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListTenants) ([]*model.Tenant, error)); ok {
+		return rf(ctx, listTenants)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListTenants) []*model.Tenant); ok {
+		r0 = rf(ctx, listTenants)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListTenants) error); ok {
+		r1 = rf(ctx, listTenants)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveCollection provides a mock function with given fields: ctx, moveCollection, ts
+func (_m *Catalog) MoveCollection(ctx context.Context, moveCollection *model.MoveCollection, ts int64) (*model.Collection, error) {
+	ret := _m.Called(ctx, moveCollection, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MoveCollection, int64) (*model.Collection, error)); ok {
+		return rf(ctx, moveCollection, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MoveCollection, int64) *model.Collection); ok {
+		r0 = rf(ctx, moveCollection, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.MoveCollection, int64) error); ok {
+		r1 = rf(ctx, moveCollection, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetState provides a mock function with given fields: ctx
 func (_m *Catalog) ResetState(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -433,6 +649,36 @@ func (_m *Catalog) ResetState(ctx context.Context) error {
 	return r0
 }
 
+// RestoreCollectionVersion provides a mock function with given fields: ctx, restoreCollectionVersion
+func (_m *Catalog) RestoreCollectionVersion(ctx context.Context, restoreCollectionVersion *model.RestoreCollectionVersion) (*model.Collection, error) {
+	ret := _m.Called(ctx, restoreCollectionVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreCollectionVersion")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RestoreCollectionVersion) (*model.Collection, error)); ok {
+		return rf(ctx, restoreCollectionVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RestoreCollectionVersion) *model.Collection); ok {
+		r0 = rf(ctx, restoreCollectionVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RestoreCollectionVersion) error); ok {
+		r1 = rf(ctx, restoreCollectionVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetTenantLastCompactionTime provides a mock function with given fields: ctx, tenantID, lastCompactionTime
 func (_m *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
 	ret := _m.Called(ctx, tenantID, lastCompactionTime)
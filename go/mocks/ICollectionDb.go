@@ -4,7 +4,10 @@ package mocks
 
 import (
 	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/chroma-core/chroma/go/pkg/model"
 )
 
 // ICollectionDb is an autogenerated mock type for the ICollectionDb type
@@ -58,9 +61,9 @@ func (_m *ICollectionDb) DeleteCollectionByID(collectionID string) (int, error)
 	return r0, r1
 }
 
-// GetCollections provides a mock function with given fields: collectionID, collectionName, tenantID, databaseName, limit, offset
-func (_m *ICollectionDb) GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*dbmodel.CollectionAndMetadata, error) {
-	ret := _m.Called(collectionID, collectionName, tenantID, databaseName, limit, offset)
+// GetCollections provides a mock function with given fields: collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where
+func (_m *ICollectionDb) GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, startAfterID *string, where *model.Where) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCollections")
@@ -68,19 +71,19 @@ func (_m *ICollectionDb) GetCollections(collectionID *string, collectionName *st
 
 	var r0 []*dbmodel.CollectionAndMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, *string, string, string, *int32, *int32) ([]*dbmodel.CollectionAndMetadata, error)); ok {
-		return rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(*string, *string, string, string, *int32, *int32, *string, *model.Where) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 	}
-	if rf, ok := ret.Get(0).(func(*string, *string, string, string, *int32, *int32) []*dbmodel.CollectionAndMetadata); ok {
-		r0 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(*string, *string, string, string, *int32, *int32, *string, *model.Where) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, *string, string, string, *int32, *int32) error); ok {
-		r1 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(1).(func(*string, *string, string, string, *int32, *int32, *string, *model.Where) error); ok {
+		r1 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,6 +109,64 @@ func (_m *ICollectionDb) Insert(in *dbmodel.Collection) error {
 	return r0
 }
 
+// RestoreLogPositionAndVersion provides a mock function with given fields: collectionID, logPosition, currentCollectionVersion
+func (_m *ICollectionDb) RestoreLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
+	ret := _m.Called(collectionID, logPosition, currentCollectionVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreLogPositionAndVersion")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int32) (int32, error)); ok {
+		return rf(collectionID, logPosition, currentCollectionVersion)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int32) int32); ok {
+		r0 = rf(collectionID, logPosition, currentCollectionVersion)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int32) error); ok {
+		r1 = rf(collectionID, logPosition, currentCollectionVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteByDatabaseID provides a mock function with given fields: databaseID
+func (_m *ICollectionDb) SoftDeleteByDatabaseID(databaseID string) ([]string, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteByDatabaseID")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: in
 func (_m *ICollectionDb) Update(in *dbmodel.Collection) error {
 	ret := _m.Called(in)
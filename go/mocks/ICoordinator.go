@@ -145,6 +145,24 @@ func (_m *ICoordinator) DeleteCollection(ctx context.Context, deleteCollection *
 	return r0
 }
 
+// DeleteDatabase provides a mock function with given fields: ctx, deleteDatabase
+func (_m *ICoordinator) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error {
+	ret := _m.Called(ctx, deleteDatabase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteDatabase) error); ok {
+		r0 = rf(ctx, deleteDatabase)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteSegment provides a mock function with given fields: ctx, segmentID
 func (_m *ICoordinator) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
 	ret := _m.Called(ctx, segmentID)
@@ -163,6 +181,24 @@ func (_m *ICoordinator) DeleteSegment(ctx context.Context, segmentID types.Uniqu
 	return r0
 }
 
+// DeleteTenant provides a mock function with given fields: ctx, deleteTenant
+func (_m *ICoordinator) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	ret := _m.Called(ctx, deleteTenant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTenant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteTenant) error); ok {
+		r0 = rf(ctx, deleteTenant)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FlushCollectionCompaction provides a mock function with given fields: ctx, flushCollectionCompaction
 func (_m *ICoordinator) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
 	ret := _m.Called(ctx, flushCollectionCompaction)
@@ -193,9 +229,69 @@ func (_m *ICoordinator) FlushCollectionCompaction(ctx context.Context, flushColl
 	return r0, r1
 }
 
-// GetCollections provides a mock function with given fields: ctx, collectionID, collectionName, tenantID, dataName, limit, offset
-func (_m *ICoordinator) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, dataName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	ret := _m.Called(ctx, collectionID, collectionName, tenantID, dataName, limit, offset)
+// ForkCollection provides a mock function with given fields: ctx, forkCollection
+func (_m *ICoordinator) ForkCollection(ctx context.Context, forkCollection *model.ForkCollection) (*model.Collection, error) {
+	ret := _m.Called(ctx, forkCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForkCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ForkCollection) (*model.Collection, error)); ok {
+		return rf(ctx, forkCollection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ForkCollection) *model.Collection); ok {
+		r0 = rf(ctx, forkCollection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ForkCollection) error); ok {
+		r1 = rf(ctx, forkCollection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionVersions provides a mock function with given fields: ctx, getCollectionVersions
+func (_m *ICoordinator) GetCollectionVersions(ctx context.Context, getCollectionVersions *model.GetCollectionVersions) ([]*model.CollectionVersion, error) {
+	ret := _m.Called(ctx, getCollectionVersions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionVersions")
+	}
+
+	var r0 []*model.CollectionVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollectionVersions) ([]*model.CollectionVersion, error)); ok {
+		return rf(ctx, getCollectionVersions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetCollectionVersions) []*model.CollectionVersion); ok {
+		r0 = rf(ctx, getCollectionVersions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetCollectionVersions) error); ok {
+		r1 = rf(ctx, getCollectionVersions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollections provides a mock function with given fields: ctx, collectionID, collectionName, tenantID, dataName, limit, offset, startAfterID, where
+func (_m *ICoordinator) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, dataName string, limit *int32, offset *int32, startAfterID types.UniqueID, where *model.Where) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, collectionID, collectionName, tenantID, dataName, limit, offset, startAfterID, where)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCollections")
@@ -203,19 +299,19 @@ func (_m *ICoordinator) GetCollections(ctx context.Context, collectionID types.U
 
 	var r0 []*model.Collection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) ([]*model.Collection, error)); ok {
-		return rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, types.UniqueID, *model.Where) ([]*model.Collection, error)); ok {
+		return rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset, startAfterID, where)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) []*model.Collection); ok {
-		r0 = rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, types.UniqueID, *model.Where) []*model.Collection); ok {
+		r0 = rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset, startAfterID, where)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Collection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) error); ok {
-		r1 = rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, types.UniqueID, *model.Where) error); ok {
+		r1 = rf(ctx, collectionID, collectionName, tenantID, dataName, limit, offset, startAfterID, where)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -253,6 +349,36 @@ func (_m *ICoordinator) GetDatabase(ctx context.Context, getDatabase *model.GetD
 	return r0, r1
 }
 
+// GetFileReferenceCounts provides a mock function with given fields: ctx, filePaths
+func (_m *ICoordinator) GetFileReferenceCounts(ctx context.Context, filePaths []string) (map[string]int64, error) {
+	ret := _m.Called(ctx, filePaths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFileReferenceCounts")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]int64, error)); ok {
+		return rf(ctx, filePaths)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]int64); ok {
+		r0 = rf(ctx, filePaths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, filePaths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSegments provides a mock function with given fields: ctx, segmentID, segmentType, scope, collectionID
 func (_m *ICoordinator) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
 	ret := _m.Called(ctx, segmentID, segmentType, scope, collectionID)
@@ -343,6 +469,96 @@ func (_m *ICoordinator) GetTenantsLastCompactionTime(ctx context.Context, tenant
 	return r0, r1
 }
 
+// ListDatabases provides a mock function with given fields: ctx, listDatabases
+func (_m *ICoordinator) ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error) {
+	ret := _m.Called(ctx, listDatabases)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabases")
+	}
+
+	var r0 []*model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListDatabases) ([]*model.Database, error)); ok {
+		return rf(ctx, listDatabases)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListDatabases) []*model.Database); ok {
+		r0 = rf(ctx, listDatabases)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListDatabases) error); ok {
+		r1 = rf(ctx, listDatabases)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenants provides a mock function with given fields: ctx, listTenants
+func (_m *ICoordinator) ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error) {
+	ret := _m.Called(ctx, listTenants)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTenants")
+	}
+
+	var r0 []*model.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListTenants) ([]*model.Tenant, error)); ok {
+		return rf(ctx, listTenants)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListTenants) []*model.Tenant); ok {
+		r0 = rf(ctx, listTenants)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListTenants) error); ok {
+		r1 = rf(ctx, listTenants)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveCollection provides a mock function with given fields: ctx, moveCollection
+func (_m *ICoordinator) MoveCollection(ctx context.Context, moveCollection *model.MoveCollection) (*model.Collection, error) {
+	ret := _m.Called(ctx, moveCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MoveCollection) (*model.Collection, error)); ok {
+		return rf(ctx, moveCollection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MoveCollection) *model.Collection); ok {
+		r0 = rf(ctx, moveCollection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.MoveCollection) error); ok {
+		r1 = rf(ctx, moveCollection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetState provides a mock function with given fields: ctx
 func (_m *ICoordinator) ResetState(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -361,6 +577,36 @@ func (_m *ICoordinator) ResetState(ctx context.Context) error {
 	return r0
 }
 
+// RestoreCollectionVersion provides a mock function with given fields: ctx, restoreCollectionVersion
+func (_m *ICoordinator) RestoreCollectionVersion(ctx context.Context, restoreCollectionVersion *model.RestoreCollectionVersion) (*model.Collection, error) {
+	ret := _m.Called(ctx, restoreCollectionVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreCollectionVersion")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RestoreCollectionVersion) (*model.Collection, error)); ok {
+		return rf(ctx, restoreCollectionVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RestoreCollectionVersion) *model.Collection); ok {
+		r0 = rf(ctx, restoreCollectionVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RestoreCollectionVersion) error); ok {
+		r1 = rf(ctx, restoreCollectionVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetTenantLastCompactionTime provides a mock function with given fields: ctx, tenantID, lastCompactionTime
 func (_m *ICoordinator) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
 	ret := _m.Called(ctx, tenantID, lastCompactionTime)
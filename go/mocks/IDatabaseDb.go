@@ -90,6 +90,36 @@ func (_m *IDatabaseDb) GetDatabases(tenantID string, databaseName string) ([]*db
 	return r0, r1
 }
 
+// GetDatabasesByTenantID provides a mock function with given fields: tenantID
+func (_m *IDatabaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Database, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabasesByTenantID")
+	}
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Database, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Database); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: in
 func (_m *IDatabaseDb) Insert(in *dbmodel.Database) error {
 	ret := _m.Called(in)
@@ -108,6 +138,64 @@ func (_m *IDatabaseDb) Insert(in *dbmodel.Database) error {
 	return r0
 }
 
+// ListDatabases provides a mock function with given fields: tenantID, startAfter, limit
+func (_m *IDatabaseDb) ListDatabases(tenantID string, startAfter string, limit int32) ([]*dbmodel.Database, error) {
+	ret := _m.Called(tenantID, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabases")
+	}
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int32) ([]*dbmodel.Database, error)); ok {
+		return rf(tenantID, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int32) []*dbmodel.Database); ok {
+		r0 = rf(tenantID, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int32) error); ok {
+		r1 = rf(tenantID, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteByID provides a mock function with given fields: databaseID
+func (_m *IDatabaseDb) SoftDeleteByID(databaseID string) (int, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteByID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(databaseID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewIDatabaseDb creates a new instance of IDatabaseDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIDatabaseDb(t interface {
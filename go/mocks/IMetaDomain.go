@@ -54,6 +54,26 @@ func (_m *IMetaDomain) CollectionMetadataDb(ctx context.Context) dbmodel.ICollec
 	return r0
 }
 
+// CollectionVersionDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionVersionDb(ctx context.Context) dbmodel.ICollectionVersionDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionVersionDb")
+	}
+
+	var r0 dbmodel.ICollectionVersionDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionVersionDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionVersionDb)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
 	ret := _m.Called(ctx)
@@ -74,6 +94,26 @@ func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
 	return r0
 }
 
+// FileReferenceDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) FileReferenceDb(ctx context.Context) dbmodel.IFileReferenceDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FileReferenceDb")
+	}
+
+	var r0 dbmodel.IFileReferenceDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IFileReferenceDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IFileReferenceDb)
+		}
+	}
+
+	return r0
+}
+
 // NotificationDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) NotificationDb(ctx context.Context) dbmodel.INotificationDb {
 	ret := _m.Called(ctx)
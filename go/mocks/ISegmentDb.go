@@ -118,6 +118,36 @@ func (_m *ISegmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSe
 	return r0
 }
 
+// SoftDeleteByCollectionID provides a mock function with given fields: collectionID
+func (_m *ISegmentDb) SoftDeleteByCollectionID(collectionID string) ([]string, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteByCollectionID")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: _a0
 func (_m *ISegmentDb) Update(_a0 *dbmodel.UpdateSegment) error {
 	ret := _m.Called(_a0)
@@ -17,6 +17,80 @@ type LogServiceClient struct {
 	mock.Mock
 }
 
+// DeleteCollectionLog provides a mock function with given fields: ctx, in, opts
+func (_m *LogServiceClient) DeleteCollectionLog(ctx context.Context, in *logservicepb.DeleteCollectionLogRequest, opts ...grpc.CallOption) (*logservicepb.DeleteCollectionLogResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollectionLog")
+	}
+
+	var r0 *logservicepb.DeleteCollectionLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.DeleteCollectionLogRequest, ...grpc.CallOption) (*logservicepb.DeleteCollectionLogResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.DeleteCollectionLogRequest, ...grpc.CallOption) *logservicepb.DeleteCollectionLogResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logservicepb.DeleteCollectionLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.DeleteCollectionLogRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportCollectionLog provides a mock function with given fields: ctx, in, opts
+func (_m *LogServiceClient) ExportCollectionLog(ctx context.Context, in *logservicepb.ExportCollectionLogRequest, opts ...grpc.CallOption) (logservicepb.LogService_ExportCollectionLogClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCollectionLog")
+	}
+
+	var r0 logservicepb.LogService_ExportCollectionLogClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.ExportCollectionLogRequest, ...grpc.CallOption) (logservicepb.LogService_ExportCollectionLogClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.ExportCollectionLogRequest, ...grpc.CallOption) logservicepb.LogService_ExportCollectionLogClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logservicepb.LogService_ExportCollectionLogClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.ExportCollectionLogRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCollectionInfoToCompact provides a mock function with given fields: ctx, in, opts
 func (_m *LogServiceClient) GetAllCollectionInfoToCompact(ctx context.Context, in *logservicepb.GetAllCollectionInfoToCompactRequest, opts ...grpc.CallOption) (*logservicepb.GetAllCollectionInfoToCompactResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -54,6 +128,43 @@ func (_m *LogServiceClient) GetAllCollectionInfoToCompact(ctx context.Context, i
 	return r0, r1
 }
 
+// ImportCollectionLog provides a mock function with given fields: ctx, in, opts
+func (_m *LogServiceClient) ImportCollectionLog(ctx context.Context, in *logservicepb.ImportCollectionLogRequest, opts ...grpc.CallOption) (*logservicepb.ImportCollectionLogResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCollectionLog")
+	}
+
+	var r0 *logservicepb.ImportCollectionLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.ImportCollectionLogRequest, ...grpc.CallOption) (*logservicepb.ImportCollectionLogResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.ImportCollectionLogRequest, ...grpc.CallOption) *logservicepb.ImportCollectionLogResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logservicepb.ImportCollectionLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.ImportCollectionLogRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PullLogs provides a mock function with given fields: ctx, in, opts
 func (_m *LogServiceClient) PullLogs(ctx context.Context, in *logservicepb.PullLogsRequest, opts ...grpc.CallOption) (*logservicepb.PullLogsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -128,6 +239,80 @@ func (_m *LogServiceClient) PushLogs(ctx context.Context, in *logservicepb.PushL
 	return r0, r1
 }
 
+// SetCollectionRetention provides a mock function with given fields: ctx, in, opts
+func (_m *LogServiceClient) SetCollectionRetention(ctx context.Context, in *logservicepb.SetCollectionRetentionRequest, opts ...grpc.CallOption) (*logservicepb.SetCollectionRetentionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionRetention")
+	}
+
+	var r0 *logservicepb.SetCollectionRetentionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.SetCollectionRetentionRequest, ...grpc.CallOption) (*logservicepb.SetCollectionRetentionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.SetCollectionRetentionRequest, ...grpc.CallOption) *logservicepb.SetCollectionRetentionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logservicepb.SetCollectionRetentionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.SetCollectionRetentionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TailLogs provides a mock function with given fields: ctx, in, opts
+func (_m *LogServiceClient) TailLogs(ctx context.Context, in *logservicepb.TailLogsRequest, opts ...grpc.CallOption) (logservicepb.LogService_TailLogsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TailLogs")
+	}
+
+	var r0 logservicepb.LogService_TailLogsClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.TailLogsRequest, ...grpc.CallOption) (logservicepb.LogService_TailLogsClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.TailLogsRequest, ...grpc.CallOption) logservicepb.LogService_TailLogsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logservicepb.LogService_TailLogsClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.TailLogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateCollectionLogOffset provides a mock function with given fields: ctx, in, opts
 func (_m *LogServiceClient) UpdateCollectionLogOffset(ctx context.Context, in *logservicepb.UpdateCollectionLogOffsetRequest, opts ...grpc.CallOption) (*logservicepb.UpdateCollectionLogOffsetResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -165,6 +350,43 @@ func (_m *LogServiceClient) UpdateCollectionLogOffset(ctx context.Context, in *l
 	return r0, r1
 }
 
+// VerifyCollectionLog provides a mock function with given fields: ctx, in, opts
+func (_m *LogServiceClient) VerifyCollectionLog(ctx context.Context, in *logservicepb.VerifyCollectionLogRequest, opts ...grpc.CallOption) (*logservicepb.VerifyCollectionLogResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyCollectionLog")
+	}
+
+	var r0 *logservicepb.VerifyCollectionLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.VerifyCollectionLogRequest, ...grpc.CallOption) (*logservicepb.VerifyCollectionLogResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.VerifyCollectionLogRequest, ...grpc.CallOption) *logservicepb.VerifyCollectionLogResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logservicepb.VerifyCollectionLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.VerifyCollectionLogRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewLogServiceClient creates a new instance of LogServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLogServiceClient(t interface {
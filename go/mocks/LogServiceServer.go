@@ -14,6 +14,54 @@ type LogServiceServer struct {
 	mock.Mock
 }
 
+// DeleteCollectionLog provides a mock function with given fields: _a0, _a1
+func (_m *LogServiceServer) DeleteCollectionLog(_a0 context.Context, _a1 *logservicepb.DeleteCollectionLogRequest) (*logservicepb.DeleteCollectionLogResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollectionLog")
+	}
+
+	var r0 *logservicepb.DeleteCollectionLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.DeleteCollectionLogRequest) (*logservicepb.DeleteCollectionLogResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.DeleteCollectionLogRequest) *logservicepb.DeleteCollectionLogResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logservicepb.DeleteCollectionLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.DeleteCollectionLogRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportCollectionLog provides a mock function with given fields: _a0, _a1
+func (_m *LogServiceServer) ExportCollectionLog(_a0 *logservicepb.ExportCollectionLogRequest, _a1 logservicepb.LogService_ExportCollectionLogServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCollectionLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*logservicepb.ExportCollectionLogRequest, logservicepb.LogService_ExportCollectionLogServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAllCollectionInfoToCompact provides a mock function with given fields: _a0, _a1
 func (_m *LogServiceServer) GetAllCollectionInfoToCompact(_a0 context.Context, _a1 *logservicepb.GetAllCollectionInfoToCompactRequest) (*logservicepb.GetAllCollectionInfoToCompactResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -44,6 +92,36 @@ func (_m *LogServiceServer) GetAllCollectionInfoToCompact(_a0 context.Context, _
 	return r0, r1
 }
 
+// ImportCollectionLog provides a mock function with given fields: _a0, _a1
+func (_m *LogServiceServer) ImportCollectionLog(_a0 context.Context, _a1 *logservicepb.ImportCollectionLogRequest) (*logservicepb.ImportCollectionLogResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCollectionLog")
+	}
+
+	var r0 *logservicepb.ImportCollectionLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.ImportCollectionLogRequest) (*logservicepb.ImportCollectionLogResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.ImportCollectionLogRequest) *logservicepb.ImportCollectionLogResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logservicepb.ImportCollectionLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.ImportCollectionLogRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PullLogs provides a mock function with given fields: _a0, _a1
 func (_m *LogServiceServer) PullLogs(_a0 context.Context, _a1 *logservicepb.PullLogsRequest) (*logservicepb.PullLogsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -104,6 +182,54 @@ func (_m *LogServiceServer) PushLogs(_a0 context.Context, _a1 *logservicepb.Push
 	return r0, r1
 }
 
+// SetCollectionRetention provides a mock function with given fields: _a0, _a1
+func (_m *LogServiceServer) SetCollectionRetention(_a0 context.Context, _a1 *logservicepb.SetCollectionRetentionRequest) (*logservicepb.SetCollectionRetentionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionRetention")
+	}
+
+	var r0 *logservicepb.SetCollectionRetentionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.SetCollectionRetentionRequest) (*logservicepb.SetCollectionRetentionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.SetCollectionRetentionRequest) *logservicepb.SetCollectionRetentionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logservicepb.SetCollectionRetentionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.SetCollectionRetentionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TailLogs provides a mock function with given fields: _a0, _a1
+func (_m *LogServiceServer) TailLogs(_a0 *logservicepb.TailLogsRequest, _a1 logservicepb.LogService_TailLogsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TailLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*logservicepb.TailLogsRequest, logservicepb.LogService_TailLogsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateCollectionLogOffset provides a mock function with given fields: _a0, _a1
 func (_m *LogServiceServer) UpdateCollectionLogOffset(_a0 context.Context, _a1 *logservicepb.UpdateCollectionLogOffsetRequest) (*logservicepb.UpdateCollectionLogOffsetResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -134,6 +260,36 @@ func (_m *LogServiceServer) UpdateCollectionLogOffset(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
+// VerifyCollectionLog provides a mock function with given fields: _a0, _a1
+func (_m *LogServiceServer) VerifyCollectionLog(_a0 context.Context, _a1 *logservicepb.VerifyCollectionLogRequest) (*logservicepb.VerifyCollectionLogResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyCollectionLog")
+	}
+
+	var r0 *logservicepb.VerifyCollectionLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.VerifyCollectionLogRequest) (*logservicepb.VerifyCollectionLogResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *logservicepb.VerifyCollectionLogRequest) *logservicepb.VerifyCollectionLogResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logservicepb.VerifyCollectionLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *logservicepb.VerifyCollectionLogRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // mustEmbedUnimplementedLogServiceServer provides a mock function with given fields:
 func (_m *LogServiceServer) mustEmbedUnimplementedLogServiceServer() {
 	_m.Called()
@@ -203,6 +203,43 @@ func (_m *SysDBClient) DeleteCollection(ctx context.Context, in *coordinatorpb.D
 	return r0, r1
 }
 
+// DeleteDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) DeleteDatabase(ctx context.Context, in *coordinatorpb.DeleteDatabaseRequest, opts ...grpc.CallOption) (*coordinatorpb.DeleteDatabaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabase")
+	}
+
+	var r0 *coordinatorpb.DeleteDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.DeleteDatabaseRequest, ...grpc.CallOption) (*coordinatorpb.DeleteDatabaseResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.DeleteDatabaseRequest, ...grpc.CallOption) *coordinatorpb.DeleteDatabaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.DeleteDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.DeleteDatabaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteSegment provides a mock function with given fields: ctx, in, opts
 func (_m *SysDBClient) DeleteSegment(ctx context.Context, in *coordinatorpb.DeleteSegmentRequest, opts ...grpc.CallOption) (*coordinatorpb.DeleteSegmentResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -240,6 +277,43 @@ func (_m *SysDBClient) DeleteSegment(ctx context.Context, in *coordinatorpb.Dele
 	return r0, r1
 }
 
+// DeleteTenant provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) DeleteTenant(ctx context.Context, in *coordinatorpb.DeleteTenantRequest, opts ...grpc.CallOption) (*coordinatorpb.DeleteTenantResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTenant")
+	}
+
+	var r0 *coordinatorpb.DeleteTenantResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.DeleteTenantRequest, ...grpc.CallOption) (*coordinatorpb.DeleteTenantResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.DeleteTenantRequest, ...grpc.CallOption) *coordinatorpb.DeleteTenantResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.DeleteTenantResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.DeleteTenantRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FlushCollectionCompaction provides a mock function with given fields: ctx, in, opts
 func (_m *SysDBClient) FlushCollectionCompaction(ctx context.Context, in *coordinatorpb.FlushCollectionCompactionRequest, opts ...grpc.CallOption) (*coordinatorpb.FlushCollectionCompactionResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -277,6 +351,80 @@ func (_m *SysDBClient) FlushCollectionCompaction(ctx context.Context, in *coordi
 	return r0, r1
 }
 
+// ForkCollection provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) ForkCollection(ctx context.Context, in *coordinatorpb.ForkCollectionRequest, opts ...grpc.CallOption) (*coordinatorpb.ForkCollectionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForkCollection")
+	}
+
+	var r0 *coordinatorpb.ForkCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ForkCollectionRequest, ...grpc.CallOption) (*coordinatorpb.ForkCollectionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ForkCollectionRequest, ...grpc.CallOption) *coordinatorpb.ForkCollectionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.ForkCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.ForkCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionVersions provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) GetCollectionVersions(ctx context.Context, in *coordinatorpb.GetCollectionVersionsRequest, opts ...grpc.CallOption) (*coordinatorpb.GetCollectionVersionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionVersions")
+	}
+
+	var r0 *coordinatorpb.GetCollectionVersionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.GetCollectionVersionsRequest, ...grpc.CallOption) (*coordinatorpb.GetCollectionVersionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.GetCollectionVersionsRequest, ...grpc.CallOption) *coordinatorpb.GetCollectionVersionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.GetCollectionVersionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.GetCollectionVersionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCollections provides a mock function with given fields: ctx, in, opts
 func (_m *SysDBClient) GetCollections(ctx context.Context, in *coordinatorpb.GetCollectionsRequest, opts ...grpc.CallOption) (*coordinatorpb.GetCollectionsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -351,6 +499,43 @@ func (_m *SysDBClient) GetDatabase(ctx context.Context, in *coordinatorpb.GetDat
 	return r0, r1
 }
 
+// GetFileReferenceCounts provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) GetFileReferenceCounts(ctx context.Context, in *coordinatorpb.GetFileReferenceCountsRequest, opts ...grpc.CallOption) (*coordinatorpb.GetFileReferenceCountsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFileReferenceCounts")
+	}
+
+	var r0 *coordinatorpb.GetFileReferenceCountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.GetFileReferenceCountsRequest, ...grpc.CallOption) (*coordinatorpb.GetFileReferenceCountsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.GetFileReferenceCountsRequest, ...grpc.CallOption) *coordinatorpb.GetFileReferenceCountsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.GetFileReferenceCountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.GetFileReferenceCountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastCompactionTimeForTenant provides a mock function with given fields: ctx, in, opts
 func (_m *SysDBClient) GetLastCompactionTimeForTenant(ctx context.Context, in *coordinatorpb.GetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*coordinatorpb.GetLastCompactionTimeForTenantResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -462,6 +647,117 @@ func (_m *SysDBClient) GetTenant(ctx context.Context, in *coordinatorpb.GetTenan
 	return r0, r1
 }
 
+// ListDatabases provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) ListDatabases(ctx context.Context, in *coordinatorpb.ListDatabasesRequest, opts ...grpc.CallOption) (*coordinatorpb.ListDatabasesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabases")
+	}
+
+	var r0 *coordinatorpb.ListDatabasesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ListDatabasesRequest, ...grpc.CallOption) (*coordinatorpb.ListDatabasesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ListDatabasesRequest, ...grpc.CallOption) *coordinatorpb.ListDatabasesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.ListDatabasesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.ListDatabasesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenants provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) ListTenants(ctx context.Context, in *coordinatorpb.ListTenantsRequest, opts ...grpc.CallOption) (*coordinatorpb.ListTenantsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTenants")
+	}
+
+	var r0 *coordinatorpb.ListTenantsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ListTenantsRequest, ...grpc.CallOption) (*coordinatorpb.ListTenantsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ListTenantsRequest, ...grpc.CallOption) *coordinatorpb.ListTenantsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.ListTenantsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.ListTenantsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveCollection provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) MoveCollection(ctx context.Context, in *coordinatorpb.MoveCollectionRequest, opts ...grpc.CallOption) (*coordinatorpb.MoveCollectionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCollection")
+	}
+
+	var r0 *coordinatorpb.MoveCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.MoveCollectionRequest, ...grpc.CallOption) (*coordinatorpb.MoveCollectionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.MoveCollectionRequest, ...grpc.CallOption) *coordinatorpb.MoveCollectionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.MoveCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.MoveCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetState provides a mock function with given fields: ctx, in, opts
 func (_m *SysDBClient) ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*coordinatorpb.ResetStateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -499,6 +795,43 @@ func (_m *SysDBClient) ResetState(ctx context.Context, in *emptypb.Empty, opts .
 	return r0, r1
 }
 
+// RestoreCollectionVersion provides a mock function with given fields: ctx, in, opts
+func (_m *SysDBClient) RestoreCollectionVersion(ctx context.Context, in *coordinatorpb.RestoreCollectionVersionRequest, opts ...grpc.CallOption) (*coordinatorpb.RestoreCollectionVersionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreCollectionVersion")
+	}
+
+	var r0 *coordinatorpb.RestoreCollectionVersionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.RestoreCollectionVersionRequest, ...grpc.CallOption) (*coordinatorpb.RestoreCollectionVersionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.RestoreCollectionVersionRequest, ...grpc.CallOption) *coordinatorpb.RestoreCollectionVersionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.RestoreCollectionVersionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.RestoreCollectionVersionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetLastCompactionTimeForTenant provides a mock function with given fields: ctx, in, opts
 func (_m *SysDBClient) SetLastCompactionTimeForTenant(ctx context.Context, in *coordinatorpb.SetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
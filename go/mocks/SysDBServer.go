@@ -166,6 +166,36 @@ func (_m *SysDBServer) DeleteCollection(_a0 context.Context, _a1 *coordinatorpb.
 	return r0, r1
 }
 
+// DeleteDatabase provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) DeleteDatabase(_a0 context.Context, _a1 *coordinatorpb.DeleteDatabaseRequest) (*coordinatorpb.DeleteDatabaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabase")
+	}
+
+	var r0 *coordinatorpb.DeleteDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.DeleteDatabaseRequest) (*coordinatorpb.DeleteDatabaseResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.DeleteDatabaseRequest) *coordinatorpb.DeleteDatabaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.DeleteDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.DeleteDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteSegment provides a mock function with given fields: _a0, _a1
 func (_m *SysDBServer) DeleteSegment(_a0 context.Context, _a1 *coordinatorpb.DeleteSegmentRequest) (*coordinatorpb.DeleteSegmentResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -196,6 +226,36 @@ func (_m *SysDBServer) DeleteSegment(_a0 context.Context, _a1 *coordinatorpb.Del
 	return r0, r1
 }
 
+// DeleteTenant provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) DeleteTenant(_a0 context.Context, _a1 *coordinatorpb.DeleteTenantRequest) (*coordinatorpb.DeleteTenantResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTenant")
+	}
+
+	var r0 *coordinatorpb.DeleteTenantResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.DeleteTenantRequest) (*coordinatorpb.DeleteTenantResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.DeleteTenantRequest) *coordinatorpb.DeleteTenantResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.DeleteTenantResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.DeleteTenantRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FlushCollectionCompaction provides a mock function with given fields: _a0, _a1
 func (_m *SysDBServer) FlushCollectionCompaction(_a0 context.Context, _a1 *coordinatorpb.FlushCollectionCompactionRequest) (*coordinatorpb.FlushCollectionCompactionResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -226,6 +286,66 @@ func (_m *SysDBServer) FlushCollectionCompaction(_a0 context.Context, _a1 *coord
 	return r0, r1
 }
 
+// ForkCollection provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) ForkCollection(_a0 context.Context, _a1 *coordinatorpb.ForkCollectionRequest) (*coordinatorpb.ForkCollectionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForkCollection")
+	}
+
+	var r0 *coordinatorpb.ForkCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ForkCollectionRequest) (*coordinatorpb.ForkCollectionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ForkCollectionRequest) *coordinatorpb.ForkCollectionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.ForkCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.ForkCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionVersions provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) GetCollectionVersions(_a0 context.Context, _a1 *coordinatorpb.GetCollectionVersionsRequest) (*coordinatorpb.GetCollectionVersionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionVersions")
+	}
+
+	var r0 *coordinatorpb.GetCollectionVersionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.GetCollectionVersionsRequest) (*coordinatorpb.GetCollectionVersionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.GetCollectionVersionsRequest) *coordinatorpb.GetCollectionVersionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.GetCollectionVersionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.GetCollectionVersionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCollections provides a mock function with given fields: _a0, _a1
 func (_m *SysDBServer) GetCollections(_a0 context.Context, _a1 *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -286,6 +406,36 @@ func (_m *SysDBServer) GetDatabase(_a0 context.Context, _a1 *coordinatorpb.GetDa
 	return r0, r1
 }
 
+// GetFileReferenceCounts provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) GetFileReferenceCounts(_a0 context.Context, _a1 *coordinatorpb.GetFileReferenceCountsRequest) (*coordinatorpb.GetFileReferenceCountsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFileReferenceCounts")
+	}
+
+	var r0 *coordinatorpb.GetFileReferenceCountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.GetFileReferenceCountsRequest) (*coordinatorpb.GetFileReferenceCountsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.GetFileReferenceCountsRequest) *coordinatorpb.GetFileReferenceCountsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.GetFileReferenceCountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.GetFileReferenceCountsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastCompactionTimeForTenant provides a mock function with given fields: _a0, _a1
 func (_m *SysDBServer) GetLastCompactionTimeForTenant(_a0 context.Context, _a1 *coordinatorpb.GetLastCompactionTimeForTenantRequest) (*coordinatorpb.GetLastCompactionTimeForTenantResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -376,6 +526,96 @@ func (_m *SysDBServer) GetTenant(_a0 context.Context, _a1 *coordinatorpb.GetTena
 	return r0, r1
 }
 
+// ListDatabases provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) ListDatabases(_a0 context.Context, _a1 *coordinatorpb.ListDatabasesRequest) (*coordinatorpb.ListDatabasesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabases")
+	}
+
+	var r0 *coordinatorpb.ListDatabasesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ListDatabasesRequest) (*coordinatorpb.ListDatabasesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ListDatabasesRequest) *coordinatorpb.ListDatabasesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.ListDatabasesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.ListDatabasesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenants provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) ListTenants(_a0 context.Context, _a1 *coordinatorpb.ListTenantsRequest) (*coordinatorpb.ListTenantsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTenants")
+	}
+
+	var r0 *coordinatorpb.ListTenantsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ListTenantsRequest) (*coordinatorpb.ListTenantsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.ListTenantsRequest) *coordinatorpb.ListTenantsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.ListTenantsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.ListTenantsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveCollection provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) MoveCollection(_a0 context.Context, _a1 *coordinatorpb.MoveCollectionRequest) (*coordinatorpb.MoveCollectionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCollection")
+	}
+
+	var r0 *coordinatorpb.MoveCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.MoveCollectionRequest) (*coordinatorpb.MoveCollectionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.MoveCollectionRequest) *coordinatorpb.MoveCollectionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.MoveCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.MoveCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetState provides a mock function with given fields: _a0, _a1
 func (_m *SysDBServer) ResetState(_a0 context.Context, _a1 *emptypb.Empty) (*coordinatorpb.ResetStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -406,6 +646,36 @@ func (_m *SysDBServer) ResetState(_a0 context.Context, _a1 *emptypb.Empty) (*coo
 	return r0, r1
 }
 
+// RestoreCollectionVersion provides a mock function with given fields: _a0, _a1
+func (_m *SysDBServer) RestoreCollectionVersion(_a0 context.Context, _a1 *coordinatorpb.RestoreCollectionVersionRequest) (*coordinatorpb.RestoreCollectionVersionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreCollectionVersion")
+	}
+
+	var r0 *coordinatorpb.RestoreCollectionVersionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.RestoreCollectionVersionRequest) (*coordinatorpb.RestoreCollectionVersionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coordinatorpb.RestoreCollectionVersionRequest) *coordinatorpb.RestoreCollectionVersionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coordinatorpb.RestoreCollectionVersionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coordinatorpb.RestoreCollectionVersionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetLastCompactionTimeForTenant provides a mock function with given fields: _a0, _a1
 func (_m *SysDBServer) SetLastCompactionTimeForTenant(_a0 context.Context, _a1 *coordinatorpb.SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
@@ -22,10 +22,12 @@ var (
 	ErrCollectionLogPositionStale            = errors.New("collection log position Stale")
 	ErrCollectionVersionStale                = errors.New("collection version stale")
 	ErrCollectionVersionInvalid              = errors.New("collection version invalid")
+	ErrCollectionVersionNotFound             = errors.New("collection version not found")
 
 	// Collection metadata errors
 	ErrUnknownCollectionMetadataType = errors.New("collection metadata value type not supported")
 	ErrInvalidMetadataUpdate         = errors.New("invalid metadata update, reest metadata true and metadata value not empty")
+	ErrInvalidWhereClause            = errors.New("invalid where clause")
 
 	// Segment errors
 	ErrSegmentIDFormat                  = errors.New("segment id format error")
@@ -18,17 +18,26 @@ type ICoordinator interface {
 	common.Component
 	ResetState(ctx context.Context) error
 	CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, error)
-	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, dataName string, limit *int32, offset *int32) ([]*model.Collection, error)
+	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, dataName string, limit *int32, offset *int32, startAfterID types.UniqueID, where *model.Where) ([]*model.Collection, error)
 	DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error
 	UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection) (*model.Collection, error)
+	MoveCollection(ctx context.Context, moveCollection *model.MoveCollection) (*model.Collection, error)
+	ForkCollection(ctx context.Context, forkCollection *model.ForkCollection) (*model.Collection, error)
+	GetFileReferenceCounts(ctx context.Context, filePaths []string) (map[string]int64, error)
+	GetCollectionVersions(ctx context.Context, getCollectionVersions *model.GetCollectionVersions) ([]*model.CollectionVersion, error)
+	RestoreCollectionVersion(ctx context.Context, restoreCollectionVersion *model.RestoreCollectionVersion) (*model.Collection, error)
 	CreateSegment(ctx context.Context, createSegment *model.CreateSegment) error
 	GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error)
 	DeleteSegment(ctx context.Context, segmentID types.UniqueID) error
 	UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment) (*model.Segment, error)
 	CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase) (*model.Database, error)
 	GetDatabase(ctx context.Context, getDatabase *model.GetDatabase) (*model.Database, error)
+	DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error
+	ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error)
 	CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error)
 	GetTenant(ctx context.Context, getTenant *model.GetTenant) (*model.Tenant, error)
+	DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error
+	ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error)
 	SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error
 	GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error)
 	FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)
@@ -54,6 +63,14 @@ func (s *Coordinator) GetDatabase(ctx context.Context, getDatabase *model.GetDat
 	return database, nil
 }
 
+func (s *Coordinator) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error {
+	return s.catalog.DeleteDatabase(ctx, deleteDatabase)
+}
+
+func (s *Coordinator) ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error) {
+	return s.catalog.ListDatabases(ctx, listDatabases)
+}
+
 func (s *Coordinator) CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error) {
 	tenant, err := s.catalog.CreateTenant(ctx, createTenant, createTenant.Ts)
 	if err != nil {
@@ -70,6 +87,14 @@ func (s *Coordinator) GetTenant(ctx context.Context, getTenant *model.GetTenant)
 	return tenant, nil
 }
 
+func (s *Coordinator) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	return s.catalog.DeleteTenant(ctx, deleteTenant)
+}
+
+func (s *Coordinator) ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error) {
+	return s.catalog.ListTenants(ctx, listTenants)
+}
+
 func (s *Coordinator) CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, error) {
 	log.Info("create collection", zap.Any("createCollection", createCollection))
 	collection, err := s.catalog.CreateCollection(ctx, createCollection, createCollection.Ts)
@@ -79,8 +104,8 @@ func (s *Coordinator) CreateCollection(ctx context.Context, createCollection *mo
 	return collection, nil
 }
 
-func (s *Coordinator) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	return s.catalog.GetCollections(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+func (s *Coordinator) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, startAfterID types.UniqueID, where *model.Where) ([]*model.Collection, error) {
+	return s.catalog.GetCollections(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, startAfterID, where)
 }
 
 func (s *Coordinator) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
@@ -91,6 +116,26 @@ func (s *Coordinator) UpdateCollection(ctx context.Context, collection *model.Up
 	return s.catalog.UpdateCollection(ctx, collection, collection.Ts)
 }
 
+func (s *Coordinator) MoveCollection(ctx context.Context, moveCollection *model.MoveCollection) (*model.Collection, error) {
+	return s.catalog.MoveCollection(ctx, moveCollection, moveCollection.Ts)
+}
+
+func (s *Coordinator) ForkCollection(ctx context.Context, forkCollection *model.ForkCollection) (*model.Collection, error) {
+	return s.catalog.ForkCollection(ctx, forkCollection, forkCollection.Ts)
+}
+
+func (s *Coordinator) GetFileReferenceCounts(ctx context.Context, filePaths []string) (map[string]int64, error) {
+	return s.catalog.GetFileReferenceCounts(ctx, filePaths)
+}
+
+func (s *Coordinator) GetCollectionVersions(ctx context.Context, getCollectionVersions *model.GetCollectionVersions) ([]*model.CollectionVersion, error) {
+	return s.catalog.GetCollectionVersions(ctx, getCollectionVersions)
+}
+
+func (s *Coordinator) RestoreCollectionVersion(ctx context.Context, restoreCollectionVersion *model.RestoreCollectionVersion) (*model.Collection, error) {
+	return s.catalog.RestoreCollectionVersion(ctx, restoreCollectionVersion)
+}
+
 func (s *Coordinator) CreateSegment(ctx context.Context, segment *model.CreateSegment) error {
 	if err := verifyCreateSegment(segment); err != nil {
 		return err
@@ -114,7 +114,7 @@ func testCollection(t *rapid.T) {
 			}
 			if err == nil {
 				// verify the correctness
-				collectionList, err := c.GetCollections(ctx, collection.ID, nil, common.DefaultTenant, common.DefaultDatabase, nil, nil)
+				collectionList, err := c.GetCollections(ctx, collection.ID, nil, common.DefaultTenant, common.DefaultDatabase, nil, nil, types.NilUniqueID(), nil)
 				if err != nil {
 					t.Fatalf("error getting collections: %v", err)
 				}
@@ -256,7 +256,7 @@ func SampleCollections(tenantID string, databaseName string) []*model.Collection
 
 func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 	ctx := context.Background()
-	results, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	results, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 
 	sort.Slice(results, func(i, j int) bool {
@@ -275,14 +275,14 @@ func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 
 	// Find by name
 	for _, collection := range suite.sampleCollections {
-		result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &collection.Name, suite.tenantName, suite.databaseName, nil, nil)
+		result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &collection.Name, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 		suite.NoError(err)
 		suite.Equal([]*model.Collection{collection}, result)
 	}
 
 	// Find by id
 	for _, collection := range suite.sampleCollections {
-		result, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+		result, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 		suite.NoError(err)
 		suite.Equal([]*model.Collection{collection}, result)
 	}
@@ -297,13 +297,13 @@ func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 	err = suite.coordinator.DeleteCollection(ctx, deleteCollection)
 	suite.NoError(err)
 
-	results, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	results, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 
 	suite.NotContains(results, c1)
 	suite.Len(results, len(suite.sampleCollections)-1)
 	suite.ElementsMatch(results, suite.sampleCollections[1:])
-	byIDResult, err := suite.coordinator.GetCollections(ctx, c1.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	byIDResult, err := suite.coordinator.GetCollections(ctx, c1.ID, nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Empty(byIDResult)
 
@@ -328,7 +328,7 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	result, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name})
 	suite.NoError(err)
 	suite.Equal(coll, result)
-	resultList, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &coll.Name, suite.tenantName, suite.databaseName, nil, nil)
+	resultList, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &coll.Name, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Equal([]*model.Collection{coll}, resultList)
 
@@ -338,7 +338,7 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Dimension: coll.Dimension})
 	suite.NoError(err)
 	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Equal([]*model.Collection{coll}, resultList)
 
@@ -349,7 +349,7 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata})
 	suite.NoError(err)
 	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Equal([]*model.Collection{coll}, resultList)
 
@@ -358,7 +358,7 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata, ResetMetadata: true})
 	suite.NoError(err)
 	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Equal([]*model.Collection{coll}, resultList)
 }
@@ -391,7 +391,7 @@ func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
 		Name: &newName1,
 	})
 	suite.NoError(err)
-	result, err := suite.coordinator.GetCollections(ctx, suite.sampleCollections[1].ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, suite.sampleCollections[1].ID, nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Len(result, 1)
 	suite.Equal(newName1, result[0].Name)
@@ -403,7 +403,7 @@ func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
 	})
 	suite.NoError(err)
 	//suite.Equal(newName0, collection.Name)
-	result, err = suite.coordinator.GetCollections(ctx, suite.sampleCollections[0].ID, nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, suite.sampleCollections[0].ID, nil, suite.tenantName, newDatabaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Len(result, 1)
 	suite.Equal(newName0, result[0].Name)
@@ -413,6 +413,112 @@ func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
 	suite.NoError(err)
 }
 
+func (suite *APIsTestSuite) TestMoveCollection() {
+	ctx := context.Background()
+	newDatabaseName := "test_apis_MoveCollection"
+	_, err := suite.coordinator.CreateDatabase(ctx, &model.CreateDatabase{
+		ID:     uuid.New().String(),
+		Name:   newDatabaseName,
+		Tenant: suite.tenantName,
+	})
+	suite.NoError(err)
+
+	// a collection with the same name already lives in the target database
+	_, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         suite.sampleCollections[0].Name,
+		TenantID:     suite.tenantName,
+		DatabaseName: newDatabaseName,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.MoveCollection(ctx, &model.MoveCollection{
+		ID:           suite.sampleCollections[0].ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: newDatabaseName,
+	})
+	suite.Equal(common.ErrCollectionUniqueConstraintViolation, err)
+
+	newName := "moved_" + suite.sampleCollections[0].Name
+	collection, err := suite.coordinator.MoveCollection(ctx, &model.MoveCollection{
+		ID:           suite.sampleCollections[0].ID,
+		Name:         &newName,
+		TenantID:     suite.tenantName,
+		DatabaseName: newDatabaseName,
+	})
+	suite.NoError(err)
+	suite.Equal(newName, collection.Name)
+	suite.Equal(newDatabaseName, collection.DatabaseName)
+
+	result, err := suite.coordinator.GetCollections(ctx, suite.sampleCollections[0].ID, nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
+	suite.NoError(err)
+	suite.Empty(result)
+	result, err = suite.coordinator.GetCollections(ctx, suite.sampleCollections[0].ID, nil, suite.tenantName, newDatabaseName, nil, nil, types.NilUniqueID(), nil)
+	suite.NoError(err)
+	suite.Len(result, 1)
+
+	_, err = suite.coordinator.MoveCollection(ctx, &model.MoveCollection{
+		ID:           suite.sampleCollections[0].ID,
+		TenantID:     suite.tenantName,
+		DatabaseName: "missing",
+	})
+	suite.Equal(common.ErrDatabaseNotFound, err)
+
+	// clean up
+	err = dao.CleanUpTestDatabase(suite.db, suite.tenantName, newDatabaseName)
+	suite.NoError(err)
+}
+
+func (suite *APIsTestSuite) TestRestoreCollectionVersion() {
+	ctx := context.Background()
+	collectionID := suite.sampleCollections[0].ID
+	segmentID := types.NewUniqueID()
+	err := suite.coordinator.CreateSegment(ctx, &model.CreateSegment{
+		ID:           segmentID,
+		Type:         "test_type",
+		Scope:        "VECTOR",
+		CollectionID: collectionID,
+	})
+	suite.NoError(err)
+	for version := int32(0); version < 2; version++ {
+		_, err = suite.coordinator.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+			ID:                       collectionID,
+			TenantID:                 suite.tenantName,
+			LogPosition:              int64(version+1) * 10,
+			CurrentCollectionVersion: version,
+			FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+				{ID: segmentID, FilePaths: map[string][]string{"test_type": {"file_" + strconv.Itoa(int(version))}}},
+			},
+		})
+		suite.NoError(err)
+	}
+
+	collection, err := suite.coordinator.RestoreCollectionVersion(ctx, &model.RestoreCollectionVersion{CollectionID: collectionID, Version: 1})
+	suite.NoError(err)
+	suite.Equal(int64(10), collection.LogPosition)
+	suite.Equal(int32(3), collection.Version)
+	segments, err := suite.coordinator.GetSegments(ctx, segmentID, nil, nil, collectionID)
+	suite.NoError(err)
+	suite.Equal([]string{"file_0"}, segments[0].FilePaths["test_type"])
+	counts, err := suite.coordinator.GetFileReferenceCounts(ctx, []string{"file_0", "file_1"})
+	suite.NoError(err)
+	// the segment and the versions 1 and 3 reference file_0, the version 2 file_1
+	suite.Equal(int64(3), counts["file_0"])
+	suite.Equal(int64(1), counts["file_1"])
+	versions, err := suite.coordinator.GetCollectionVersions(ctx, &model.GetCollectionVersions{CollectionID: collectionID})
+	suite.NoError(err)
+	suite.Len(versions, 3)
+	suite.Equal(int64(10), versions[2].LogPosition)
+
+	_, err = suite.coordinator.RestoreCollectionVersion(ctx, &model.RestoreCollectionVersion{CollectionID: collectionID, Version: 10})
+	suite.Equal(common.ErrCollectionVersionNotFound, err)
+	_, err = suite.coordinator.RestoreCollectionVersion(ctx, &model.RestoreCollectionVersion{CollectionID: types.NewUniqueID(), Version: 1})
+	suite.Equal(common.ErrCollectionNotFound, err)
+
+	// clean up
+	err = suite.coordinator.DeleteSegment(ctx, segmentID)
+	suite.NoError(err)
+}
+
 func (suite *APIsTestSuite) TestGetMultipleWithDatabase() {
 	newDatabaseName := "test_apis_GetMultipleWithDatabase"
 	ctx := context.Background()
@@ -441,7 +547,7 @@ func (suite *APIsTestSuite) TestGetMultipleWithDatabase() {
 		suite.NoError(err)
 		suite.sampleCollections[index] = collection
 	}
-	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Equal(len(suite.sampleCollections), len(result))
 	sort.Slice(result, func(i, j int) bool {
@@ -449,7 +555,7 @@ func (suite *APIsTestSuite) TestGetMultipleWithDatabase() {
 	})
 	suite.Equal(suite.sampleCollections, result)
 
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Equal(len(suite.sampleCollections), len(result))
 
@@ -526,7 +632,7 @@ func (suite *APIsTestSuite) TestCreateDatabaseWithTenants() {
 	expected := []*model.Collection{suite.sampleCollections[0]}
 	expected[0].TenantID = newTenantName
 	expected[0].DatabaseName = newDatabaseName
-	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, newDatabaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, newDatabaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Len(result, 1)
 	suite.Equal(expected[0], result[0])
@@ -534,14 +640,14 @@ func (suite *APIsTestSuite) TestCreateDatabaseWithTenants() {
 	expected = []*model.Collection{suite.sampleCollections[1]}
 	expected[0].TenantID = suite.tenantName
 	expected[0].DatabaseName = newDatabaseName
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Len(result, 1)
 	suite.Equal(expected[0], result[0])
 
 	// A new tenant DOES NOT have a default database. This does not error, instead 0
 	// results are returned
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, suite.databaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, suite.databaseName, nil, nil, types.NilUniqueID(), nil)
 	suite.NoError(err)
 	suite.Nil(result)
 
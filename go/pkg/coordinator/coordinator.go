@@ -32,9 +32,13 @@ func NewCoordinator(ctx context.Context, db *gorm.DB, notificationStore notifica
 	s.notificationProcessor = notificationProcessor
 
 	// catalog
-	txnImpl := dbcore.NewTxImpl()
-	metaDomain := dao.NewMetaDomain()
-	s.catalog = coordinator.NewTableCatalogWithNotification(txnImpl, metaDomain, notificationStore)
+	if db == nil {
+		s.catalog = coordinator.NewMemoryCatalogWithNotification(notificationStore)
+	} else {
+		txnImpl := dbcore.NewTxImpl()
+		metaDomain := dao.NewMetaDomain()
+		s.catalog = coordinator.NewTableCatalogWithNotification(txnImpl, metaDomain, notificationStore)
+	}
 	return s, nil
 }
 
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
@@ -112,12 +114,39 @@ func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetColle
 		return res, nil
 	}
 
-	collections, err := s.coordinator.GetCollections(ctx, parsedCollectionID, collectionName, tenantID, databaseName, limit, offset)
+	startAfterID, err := decodeCollectionPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	where, err := convertWhereToModel(req.Where)
+	if err != nil {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("where", err.Error())
+		if err != nil {
+			return nil, err
+		}
+		return nil, grpcError
+	}
+
+	// Fetch one more collection than requested to know whether there is a next page.
+	var queryLimit *int32
+	if limit != nil {
+		limitPlusOne := *limit + 1
+		queryLimit = &limitPlusOne
+	}
+
+	collections, err := s.coordinator.GetCollections(ctx, parsedCollectionID, collectionName, tenantID, databaseName, queryLimit, offset, startAfterID, where)
 	if err != nil {
 		log.Error("error getting collections", zap.Error(err))
 		res.Status = failResponseWithError(err, errorCode)
 		return res, nil
 	}
+	if limit != nil && len(collections) > int(*limit) {
+		collections = collections[:*limit]
+		if len(collections) > 0 {
+			res.NextPageToken = encodePageToken(collections[len(collections)-1].ID.String())
+		}
+	}
 	res.Collections = make([]*coordinatorpb.Collection, 0, len(collections))
 	for _, collection := range collections {
 		collectionpb := convertCollectionToProto(collection)
@@ -206,14 +235,184 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 	_, err = s.coordinator.UpdateCollection(ctx, updateCollection)
 	if err != nil {
 		log.Error("error updating collection", zap.Error(err))
+		if err == common.ErrCollectionUniqueConstraintViolation {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) MoveCollection(ctx context.Context, req *coordinatorpb.MoveCollectionRequest) (*coordinatorpb.MoveCollectionResponse, error) {
+	res := &coordinatorpb.MoveCollectionResponse{}
+
+	collectionID := req.Id
+	parsedCollectionID, err := types.ToUniqueID(&collectionID)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collectionpd.id", collectionID))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, errorCode)
+		return res, nil
+	}
+	if req.Name != nil && *req.Name == "" {
+		res.Status = failResponseWithError(common.ErrCollectionNameEmpty, errorCode)
+		return res, nil
+	}
+
+	moveCollection := &model.MoveCollection{
+		ID:           parsedCollectionID,
+		Name:         req.Name,
+		TenantID:     req.Tenant,
+		DatabaseName: req.Database,
+	}
+	collection, err := s.coordinator.MoveCollection(ctx, moveCollection)
+	if err != nil {
+		log.Error("error moving collection", zap.Error(err))
+		switch err {
+		case common.ErrCollectionNotFound, common.ErrDatabaseNotFound:
+			res.Status = failResponseWithError(err, 404)
+		case common.ErrCollectionUniqueConstraintViolation:
+			res.Status = failResponseWithError(err, 409)
+		default:
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+
+	res.Collection = convertCollectionToProto(collection)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ForkCollection(ctx context.Context, req *coordinatorpb.ForkCollectionRequest) (*coordinatorpb.ForkCollectionResponse, error) {
+	res := &coordinatorpb.ForkCollectionResponse{}
+
+	sourceCollectionID, err := types.ToUniqueID(&req.SourceCollectionId)
+	err = grpcutils.BuildErrorForUUID(sourceCollectionID, "source_collection", err)
+	if err != nil {
+		return nil, err
+	}
+	targetCollectionID, err := types.ToUniqueID(&req.TargetCollectionId)
+	err = grpcutils.BuildErrorForUUID(targetCollectionID, "target_collection", err)
+	if err != nil {
+		return nil, err
+	}
+	if req.TargetCollectionName == "" {
+		res.Status = failResponseWithError(common.ErrCollectionNameEmpty, errorCode)
+		return res, nil
+	}
+
+	forkCollection := &model.ForkCollection{
+		SourceCollectionID:   sourceCollectionID,
+		TargetCollectionID:   targetCollectionID,
+		TargetCollectionName: req.TargetCollectionName,
+	}
+	collection, err := s.coordinator.ForkCollection(ctx, forkCollection)
+	if err != nil {
+		log.Error("error forking collection", zap.Error(err))
+		switch err {
+		case common.ErrCollectionNotFound:
+			res.Status = failResponseWithError(err, 404)
+		case common.ErrCollectionUniqueConstraintViolation:
+			res.Status = failResponseWithError(err, 409)
+		default:
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+
+	res.Collection = convertCollectionToProto(collection)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// GetFileReferenceCounts returns how many segments and collection versions use
+// each file, a file without references can be deleted.
+func (s *Server) GetFileReferenceCounts(ctx context.Context, req *coordinatorpb.GetFileReferenceCountsRequest) (*coordinatorpb.GetFileReferenceCountsResponse, error) {
+	res := &coordinatorpb.GetFileReferenceCountsResponse{}
+
+	counts, err := s.coordinator.GetFileReferenceCounts(ctx, req.FilePaths)
+	if err != nil {
+		log.Error("error getting file reference counts", zap.Error(err))
 		res.Status = failResponseWithError(err, errorCode)
 		return res, nil
 	}
+	res.ReferenceCounts = counts
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) RestoreCollectionVersion(ctx context.Context, req *coordinatorpb.RestoreCollectionVersionRequest) (*coordinatorpb.RestoreCollectionVersionResponse, error) {
+	res := &coordinatorpb.RestoreCollectionVersionResponse{}
+
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+
+	// The log service has to replay the records written after the restored version.
+	// Its offset is rewound first, so that a failure leaves the collection as it was.
+	// If the restore fails after it, the records are compacted again on top of the
+	// current version until the restore is retried.
+	if s.logServiceClient != nil {
+		versions, err := s.coordinator.GetCollectionVersions(ctx, &model.GetCollectionVersions{
+			CollectionID:      collectionID,
+			StartAfterVersion: req.Version - 1,
+			Limit:             1,
+		})
+		if err == nil && (len(versions) == 0 || versions[0].Version != req.Version) {
+			err = common.ErrCollectionVersionNotFound
+		}
+		if err != nil {
+			log.Error("error getting collection version", zap.Error(err))
+			res.Status = restoreCollectionVersionFailure(err)
+			return res, nil
+		}
+		_, err = s.logServiceClient.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+			CollectionId:    collectionID.String(),
+			LogOffset:       versions[0].LogPosition,
+			AllowRegression: true,
+		})
+		if err != nil {
+			log.Error("error rewinding collection log offset", zap.String("collectionId", collectionID.String()), zap.Error(err))
+			res.Status = failResponseWithError(err, errorCode)
+			return res, nil
+		}
+	} else {
+		log.Warn("no log service configured, the collection log offset is not rewound", zap.String("collectionId", collectionID.String()))
+	}
+
+	restoreCollectionVersion := &model.RestoreCollectionVersion{
+		CollectionID: collectionID,
+		Version:      req.Version,
+	}
+	collection, err := s.coordinator.RestoreCollectionVersion(ctx, restoreCollectionVersion)
+	if err != nil {
+		log.Error("error restoring collection version", zap.Error(err))
+		res.Status = restoreCollectionVersionFailure(err)
+		return res, nil
+	}
 
+	res.Collection = convertCollectionToProto(collection)
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
 
+func restoreCollectionVersionFailure(err error) *coordinatorpb.Status {
+	switch err {
+	case common.ErrCollectionNotFound, common.ErrCollectionVersionNotFound:
+		return failResponseWithError(err, 404)
+	case common.ErrCollectionVersionStale:
+		return failResponseWithError(err, 409)
+	default:
+		return failResponseWithError(err, errorCode)
+	}
+}
+
 func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinatorpb.FlushCollectionCompactionRequest) (*coordinatorpb.FlushCollectionCompactionResponse, error) {
 	blob, err := json.Marshal(req)
 	if err != nil {
@@ -274,3 +473,48 @@ func setResponseStatus(code int32) *coordinatorpb.Status {
 		Code:   code,
 	}
 }
+
+func (s *Server) GetCollectionVersions(ctx context.Context, req *coordinatorpb.GetCollectionVersionsRequest) (*coordinatorpb.GetCollectionVersionsResponse, error) {
+	res := &coordinatorpb.GetCollectionVersionsResponse{}
+
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	err = grpcutils.BuildErrorForUUID(collectionID, "collection", err)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := getPageSize(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	startAfterVersion, err := decodeCollectionVersionPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one more version than requested to know whether there is a next page.
+	getCollectionVersions := &model.GetCollectionVersions{
+		CollectionID:      collectionID,
+		StartAfterVersion: startAfterVersion,
+		Limit:             pageSize + 1,
+	}
+	versions, err := s.coordinator.GetCollectionVersions(ctx, getCollectionVersions)
+	if err != nil {
+		log.Error("error getting collection versions", zap.Error(err))
+		if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	if len(versions) > int(pageSize) {
+		versions = versions[:pageSize]
+		res.NextPageToken = encodePageToken(strconv.Itoa(int(versions[len(versions)-1].Version)))
+	}
+	res.Versions = make([]*coordinatorpb.CollectionVersion, 0, len(versions))
+	for _, version := range versions {
+		res.Versions = append(res.Versions, convertCollectionVersionToProto(version))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
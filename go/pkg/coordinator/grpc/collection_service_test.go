@@ -2,18 +2,27 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"reflect"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/chroma-core/chroma/go/mocks"
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 	"github.com/chroma-core/chroma/go/pkg/metastore/coordinator"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/notification"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc/codes"
@@ -170,6 +179,516 @@ func TestCollection(t *testing.T) {
 	// rapid.Check(t, testCollection)
 }
 
+func TestServer_GetCollectionsPageToken(t *testing.T) {
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "memory",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	var collectionIDs []string
+	for i := 0; i < 5; i++ {
+		collectionID := types.NewUniqueID().String()
+		res, err := s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID,
+			Name:     "collection_" + strconv.Itoa(i),
+			Tenant:   common.DefaultTenant,
+			Database: common.DefaultDatabase,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	sort.Strings(collectionIDs)
+
+	// page through the collections
+	limit := int32(2)
+	var listed []string
+	var pageToken *string
+	for {
+		res, err := s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+			Tenant:    common.DefaultTenant,
+			Database:  common.DefaultDatabase,
+			Limit:     &limit,
+			PageToken: pageToken,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+		assert.LessOrEqual(t, len(res.Collections), int(limit))
+		for _, collection := range res.Collections {
+			listed = append(listed, collection.Id)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		pageToken = &res.NextPageToken
+	}
+	assert.Equal(t, collectionIDs, listed)
+
+	// limit and offset keep working without a page token
+	offset := int32(4)
+	res, err := s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+		Limit:    &limit,
+		Offset:   &offset,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Collections, 1)
+	assert.Equal(t, collectionIDs[4], res.Collections[0].Id)
+	assert.Empty(t, res.NextPageToken)
+
+	malformed := encodePageToken("not a collection id")
+	_, err = s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{PageToken: &malformed})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetCollectionsWhere(t *testing.T) {
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "memory",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	collectionNames := map[string]string{}
+	for i, project := range []string{"x", "x", "y", "x"} {
+		collectionID := types.NewUniqueID().String()
+		res, err := s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID,
+			Name:     "collection_" + strconv.Itoa(i),
+			Tenant:   common.DefaultTenant,
+			Database: common.DefaultDatabase,
+			Metadata: &coordinatorpb.UpdateMetadata{
+				Metadata: map[string]*coordinatorpb.UpdateMetadataValue{
+					"project": {Value: &coordinatorpb.UpdateMetadataValue_StringValue{StringValue: project}},
+					"version": {Value: &coordinatorpb.UpdateMetadataValue_IntValue{IntValue: int64(i + 2)}},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+		collectionNames[collectionID] = "collection_" + strconv.Itoa(i)
+	}
+
+	projectEq := func(project string) *coordinatorpb.Where {
+		return &coordinatorpb.Where{Where: &coordinatorpb.Where_DirectComparison{DirectComparison: &coordinatorpb.DirectComparison{
+			Key: "project",
+			Comparison: &coordinatorpb.DirectComparison_SingleStringOperand{SingleStringOperand: &coordinatorpb.SingleStringComparison{
+				Value:      project,
+				Comparator: coordinatorpb.GenericComparator_EQ,
+			}},
+		}}}
+	}
+	versionGt := func(version int64) *coordinatorpb.Where {
+		return &coordinatorpb.Where{Where: &coordinatorpb.Where_DirectComparison{DirectComparison: &coordinatorpb.DirectComparison{
+			Key: "version",
+			Comparison: &coordinatorpb.DirectComparison_SingleIntOperand{SingleIntOperand: &coordinatorpb.SingleIntComparison{
+				Value:      version,
+				Comparator: &coordinatorpb.SingleIntComparison_NumberComparator{NumberComparator: coordinatorpb.NumberComparator_GT},
+			}},
+		}}}
+	}
+	versionIn := func(operator coordinatorpb.ListOperator, versions ...int64) *coordinatorpb.Where {
+		return &coordinatorpb.Where{Where: &coordinatorpb.Where_DirectComparison{DirectComparison: &coordinatorpb.DirectComparison{
+			Key: "version",
+			Comparison: &coordinatorpb.DirectComparison_IntListOperand{IntListOperand: &coordinatorpb.IntListComparison{
+				Values:       versions,
+				ListOperator: operator,
+			}},
+		}}}
+	}
+	children := func(operator coordinatorpb.BooleanOperator, children ...*coordinatorpb.Where) *coordinatorpb.Where {
+		return &coordinatorpb.Where{Where: &coordinatorpb.Where_Children{Children: &coordinatorpb.WhereChildren{
+			Children: children,
+			Operator: operator,
+		}}}
+	}
+	getNames := func(where *coordinatorpb.Where) []string {
+		res, err := s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+			Tenant:   common.DefaultTenant,
+			Database: common.DefaultDatabase,
+			Where:    where,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+		names := make([]string, 0, len(res.Collections))
+		for _, collection := range res.Collections {
+			names = append(names, collectionNames[collection.Id])
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	assert.Equal(t, []string{"collection_0", "collection_1", "collection_3"}, getNames(projectEq("x")))
+	assert.Equal(t, []string{"collection_1", "collection_3"}, getNames(children(coordinatorpb.BooleanOperator_AND, projectEq("x"), versionGt(2))))
+	assert.Equal(t, []string{"collection_0", "collection_2"}, getNames(children(coordinatorpb.BooleanOperator_OR, projectEq("y"), versionIn(coordinatorpb.ListOperator_IN, 2))))
+	assert.Equal(t, []string{"collection_0", "collection_2"}, getNames(versionIn(coordinatorpb.ListOperator_NIN, 3, 5)))
+	assert.Empty(t, getNames(projectEq("z")))
+
+	_, err = s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+		Where:    children(coordinatorpb.BooleanOperator_AND),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_MoveCollection(t *testing.T) {
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "memory",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	databaseRes, err := s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     types.NewUniqueID().String(),
+		Name:   "target",
+		Tenant: common.DefaultTenant,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), databaseRes.Status.Code)
+	collectionIDs := make([]string, 0, 2)
+	for _, database := range []string{common.DefaultDatabase, "target"} {
+		collectionID := types.NewUniqueID().String()
+		res, err := s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionID,
+			Name:     "docs",
+			Tenant:   common.DefaultTenant,
+			Database: database,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	res, err := s.MoveCollection(ctx, &coordinatorpb.MoveCollectionRequest{
+		Id:       collectionIDs[0],
+		Tenant:   common.DefaultTenant,
+		Database: "target",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(409), res.Status.Code)
+
+	newName := "docs_moved"
+	res, err = s.MoveCollection(ctx, &coordinatorpb.MoveCollectionRequest{
+		Id:       collectionIDs[0],
+		Tenant:   common.DefaultTenant,
+		Database: "target",
+		Name:     &newName,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), res.Status.Code)
+	assert.Equal(t, newName, res.Collection.Name)
+	assert.Equal(t, "target", res.Collection.Database)
+
+	res, err = s.MoveCollection(ctx, &coordinatorpb.MoveCollectionRequest{
+		Id:       collectionIDs[0],
+		Tenant:   common.DefaultTenant,
+		Database: "missing",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(404), res.Status.Code)
+}
+
+func TestServer_ForkCollection(t *testing.T) {
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "memory",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	sourceID := types.NewUniqueID().String()
+	createRes, err := s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       sourceID,
+		Name:     "source",
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), createRes.Status.Code)
+
+	forkID := types.NewUniqueID().String()
+	res, err := s.ForkCollection(ctx, &coordinatorpb.ForkCollectionRequest{
+		SourceCollectionId:   sourceID,
+		TargetCollectionId:   forkID,
+		TargetCollectionName: "fork",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), res.Status.Code)
+	assert.Equal(t, forkID, res.Collection.Id)
+	assert.Equal(t, common.DefaultDatabase, res.Collection.Database)
+	// the fork has a log of its own
+	assert.Equal(t, int64(0), res.Collection.LogPosition)
+
+	res, err = s.ForkCollection(ctx, &coordinatorpb.ForkCollectionRequest{
+		SourceCollectionId:   sourceID,
+		TargetCollectionId:   types.NewUniqueID().String(),
+		TargetCollectionName: "fork",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(409), res.Status.Code)
+	res, err = s.ForkCollection(ctx, &coordinatorpb.ForkCollectionRequest{
+		SourceCollectionId:   sourceID,
+		TargetCollectionId:   forkID,
+		TargetCollectionName: "other fork",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(409), res.Status.Code)
+
+	res, err = s.ForkCollection(ctx, &coordinatorpb.ForkCollectionRequest{
+		SourceCollectionId:   types.NewUniqueID().String(),
+		TargetCollectionId:   types.NewUniqueID().String(),
+		TargetCollectionName: "other",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(404), res.Status.Code)
+
+	_, err = s.ForkCollection(ctx, &coordinatorpb.ForkCollectionRequest{
+		SourceCollectionId:   "not a uuid",
+		TargetCollectionId:   types.NewUniqueID().String(),
+		TargetCollectionName: "other",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetCollectionVersions(t *testing.T) {
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "memory",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	collectionID := types.NewUniqueID().String()
+	createRes, err := s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "collection",
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), createRes.Status.Code)
+	segmentID := types.NewUniqueID().String()
+	segmentRes, err := s.CreateSegment(ctx, &coordinatorpb.CreateSegmentRequest{
+		Segment: &coordinatorpb.Segment{
+			Id:         segmentID,
+			Type:       "test_type",
+			Scope:      coordinatorpb.SegmentScope_VECTOR,
+			Collection: &collectionID,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), segmentRes.Status.Code)
+
+	for version := int32(0); version < 3; version++ {
+		_, err := s.FlushCollectionCompaction(ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+			TenantId:          common.DefaultTenant,
+			CollectionId:      collectionID,
+			LogPosition:       int64(version+1) * 10,
+			CollectionVersion: version,
+			SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{{
+				SegmentId: segmentID,
+				FilePaths: map[string]*coordinatorpb.FilePaths{
+					"test_type": {Paths: []string{"file_" + strconv.Itoa(int(version))}},
+				},
+			}},
+		})
+		assert.NoError(t, err)
+	}
+
+	limit := int32(2)
+	var versions []*coordinatorpb.CollectionVersion
+	var pageToken *string
+	for {
+		res, err := s.GetCollectionVersions(ctx, &coordinatorpb.GetCollectionVersionsRequest{
+			CollectionId: collectionID,
+			Limit:        &limit,
+			PageToken:    pageToken,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+		versions = append(versions, res.Versions...)
+		if res.NextPageToken == "" {
+			break
+		}
+		pageToken = &res.NextPageToken
+	}
+	assert.Len(t, versions, 3)
+	for i, version := range versions {
+		assert.Equal(t, int32(i+1), version.Version)
+		assert.Equal(t, int64(i+1)*10, version.LogPosition)
+		assert.Len(t, version.SegmentCompactionInfo, 1)
+		assert.Equal(t, segmentID, version.SegmentCompactionInfo[0].SegmentId)
+		assert.Equal(t, []string{"file_" + strconv.Itoa(i)}, version.SegmentCompactionInfo[0].FilePaths["test_type"].Paths)
+	}
+
+	res, err := s.GetCollectionVersions(ctx, &coordinatorpb.GetCollectionVersionsRequest{CollectionId: types.NewUniqueID().String()})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(404), res.Status.Code)
+
+	malformed := encodePageToken("not a version")
+	_, err = s.GetCollectionVersions(ctx, &coordinatorpb.GetCollectionVersionsRequest{CollectionId: collectionID, PageToken: &malformed})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the versions hold the files the segment moved on from
+	countsRes, err := s.GetFileReferenceCounts(ctx, &coordinatorpb.GetFileReferenceCountsRequest{
+		FilePaths: []string{"file_0", "file_2", "file_3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), countsRes.Status.Code)
+	assert.Equal(t, map[string]int64{"file_0": 1, "file_2": 2}, countsRes.ReferenceCounts)
+}
+
+func TestServer_DeleteCollectionNotificationDeletesLog(t *testing.T) {
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "memory",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, nil)
+	assert.NoError(t, err)
+	logServiceClient := &mocks.LogServiceClient{}
+	s.logServiceClient = logServiceClient
+	notifier := &logDeletionNotifier{Notifier: notification.NewMemoryNotifier(), server: s}
+	ctx := context.Background()
+
+	_, err = s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+		Id:     types.NewUniqueID().String(),
+		Name:   "database",
+		Tenant: common.DefaultTenant,
+	})
+	assert.NoError(t, err)
+	collectionIDs := []string{types.NewUniqueID().String(), types.NewUniqueID().String()}
+	for i, databaseName := range []string{"database", common.DefaultDatabase} {
+		_, err = s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+			Id:       collectionIDs[i],
+			Name:     "collection",
+			Tenant:   common.DefaultTenant,
+			Database: databaseName,
+		})
+		assert.NoError(t, err)
+	}
+	// the collections of a deleted database are deleted from the sysdb only, their
+	// logs follow from the notifications
+	res, err := s.DeleteDatabase(ctx, &coordinatorpb.DeleteDatabaseRequest{
+		Name:   "database",
+		Tenant: common.DefaultTenant,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), res.Status.Code)
+	logServiceClient.AssertNotCalled(t, "DeleteCollectionLog", mock.Anything, mock.Anything)
+
+	deleted := []model.Notification{{
+		CollectionID: collectionIDs[0],
+		Type:         model.NotificationTypeDeleteCollection,
+		Status:       model.NotificationStatusPending,
+	}}
+	request := &logservicepb.DeleteCollectionLogRequest{CollectionId: collectionIDs[0]}
+	// a failure keeps the notification to retry it
+	logServiceClient.On("DeleteCollectionLog", mock.Anything, request).Return(nil, errors.New("log service unavailable")).Once()
+	assert.Error(t, notifier.Notify(ctx, deleted))
+	logServiceClient.On("DeleteCollectionLog", mock.Anything, request).Return(&logservicepb.DeleteCollectionLogResponse{}, nil).Once()
+	assert.NoError(t, notifier.Notify(ctx, deleted))
+	logServiceClient.AssertExpectations(t)
+
+	// the log of a collection that still exists is left alone
+	assert.NoError(t, notifier.Notify(ctx, []model.Notification{{
+		CollectionID: collectionIDs[1],
+		Type:         model.NotificationTypeDeleteCollection,
+		Status:       model.NotificationStatusPending,
+	}}))
+	logServiceClient.AssertNumberOfCalls(t, "DeleteCollectionLog", 2)
+}
+
+func TestServer_RestoreCollectionVersion(t *testing.T) {
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "memory",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, nil)
+	assert.NoError(t, err)
+	logServiceClient := &mocks.LogServiceClient{}
+	s.logServiceClient = logServiceClient
+	ctx := context.Background()
+
+	collectionID := types.NewUniqueID().String()
+	_, err = s.CreateCollection(ctx, &coordinatorpb.CreateCollectionRequest{
+		Id:       collectionID,
+		Name:     "collection",
+		Tenant:   common.DefaultTenant,
+		Database: common.DefaultDatabase,
+	})
+	assert.NoError(t, err)
+	segmentID := types.NewUniqueID().String()
+	_, err = s.CreateSegment(ctx, &coordinatorpb.CreateSegmentRequest{
+		Segment: &coordinatorpb.Segment{
+			Id:         segmentID,
+			Type:       "test_type",
+			Scope:      coordinatorpb.SegmentScope_VECTOR,
+			Collection: &collectionID,
+		},
+	})
+	assert.NoError(t, err)
+	for version := int32(0); version < 2; version++ {
+		_, err := s.FlushCollectionCompaction(ctx, &coordinatorpb.FlushCollectionCompactionRequest{
+			TenantId:          common.DefaultTenant,
+			CollectionId:      collectionID,
+			LogPosition:       int64(version+1) * 10,
+			CollectionVersion: version,
+			SegmentCompactionInfo: []*coordinatorpb.FlushSegmentCompactionInfo{{
+				SegmentId: segmentID,
+				FilePaths: map[string]*coordinatorpb.FilePaths{
+					"test_type": {Paths: []string{"file_" + strconv.Itoa(int(version))}},
+				},
+			}},
+		})
+		assert.NoError(t, err)
+	}
+
+	logServiceClient.On("UpdateCollectionLogOffset", mock.Anything, &logservicepb.UpdateCollectionLogOffsetRequest{
+		CollectionId:    collectionID,
+		LogOffset:       10,
+		AllowRegression: true,
+	}).Return(&logservicepb.UpdateCollectionLogOffsetResponse{}, nil).Once()
+	res, err := s.RestoreCollectionVersion(ctx, &coordinatorpb.RestoreCollectionVersionRequest{CollectionId: collectionID, Version: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), res.Status.Code)
+	assert.Equal(t, int64(10), res.Collection.LogPosition)
+	assert.Equal(t, int32(3), res.Collection.Version)
+	logServiceClient.AssertExpectations(t)
+
+	segmentsRes, err := s.GetSegments(ctx, &coordinatorpb.GetSegmentsRequest{Id: &segmentID})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"file_0"}, segmentsRes.Segments[0].FilePaths["test_type"].Paths)
+
+	// the collection is left alone when the log offset cannot be rewound
+	logServiceClient.On("UpdateCollectionLogOffset", mock.Anything, mock.Anything).Return(nil, errors.New("log service unavailable")).Once()
+	res, err = s.RestoreCollectionVersion(ctx, &coordinatorpb.RestoreCollectionVersionRequest{CollectionId: collectionID, Version: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(errorCode), res.Status.Code)
+	collectionsRes, err := s.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{Id: &collectionID})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), collectionsRes.Collections[0].Version)
+	assert.Equal(t, int64(10), collectionsRes.Collections[0].LogPosition)
+
+	res, err = s.RestoreCollectionVersion(ctx, &coordinatorpb.RestoreCollectionVersionRequest{CollectionId: collectionID, Version: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(404), res.Status.Code)
+	res, err = s.RestoreCollectionVersion(ctx, &coordinatorpb.RestoreCollectionVersionRequest{CollectionId: types.NewUniqueID().String(), Version: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(404), res.Status.Code)
+	_, err = s.RestoreCollectionVersion(ctx, &coordinatorpb.RestoreCollectionVersionRequest{CollectionId: "not a uuid", Version: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func validateDatabase(suite *CollectionServiceTestSuite, collectionId string, collection *coordinatorpb.Collection, filePaths map[string]map[string]*coordinatorpb.FilePaths) {
 	getCollectionReq := coordinatorpb.GetCollectionsRequest{
 		Id: &collectionId,
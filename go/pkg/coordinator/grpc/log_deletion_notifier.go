@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// logDeletionNotifier deletes the log of the collections in the delete_collection
+// notifications before passing the notifications on. The catalog writes these
+// notifications with every collection it deletes, including the ones of a deleted
+// database or tenant, and they stay in the notification store until they are
+// sent, so that a failure of the log service is retried.
+type logDeletionNotifier struct {
+	notification.Notifier
+	server *Server
+}
+
+var _ notification.Notifier = &logDeletionNotifier{}
+
+func (n *logDeletionNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	for _, msg := range notifications {
+		if msg.Type != model.NotificationTypeDeleteCollection {
+			continue
+		}
+		if err := n.server.deleteCollectionLog(ctx, msg.CollectionID); err != nil {
+			return err
+		}
+	}
+	return n.Notifier.Notify(ctx, notifications)
+}
+
+// deleteCollectionLog drops the log of a collection deleted from the sysdb. The
+// log of a collection that still exists is left alone, its notification is for a
+// deletion that did not happen.
+func (s *Server) deleteCollectionLog(ctx context.Context, collectionID string) error {
+	if s.logServiceClient == nil {
+		return nil
+	}
+	parsedCollectionID, err := types.Parse(collectionID)
+	if err != nil {
+		log.Error("invalid collection id in notification", zap.String("collectionId", collectionID), zap.Error(err))
+		return nil
+	}
+	collections, err := s.coordinator.GetCollections(ctx, parsedCollectionID, nil, "", "", nil, nil, types.NilUniqueID(), nil)
+	if err != nil {
+		return err
+	}
+	if len(collections) > 0 {
+		log.Warn("collection of a delete notification exists, keeping its log", zap.String("collectionId", collectionID))
+		return nil
+	}
+	_, err = s.logServiceClient.DeleteCollectionLog(ctx, &logservicepb.DeleteCollectionLogRequest{
+		CollectionId: collectionID,
+	})
+	if err != nil {
+		log.Error("error deleting collection log", zap.String("collectionId", collectionID), zap.Error(err))
+		return err
+	}
+	return nil
+}
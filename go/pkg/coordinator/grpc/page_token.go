@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// Page tokens are opaque to clients. They carry the key of the last item on the
+// previous page, so the next page is read with a keyset query instead of an offset.
+func encodePageToken(lastKey string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+}
+
+func decodePageToken(pageToken string) (string, error) {
+	if pageToken == "" {
+		return "", nil
+	}
+	lastKey, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil || len(lastKey) == 0 {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("page_token", "malformed page token")
+		if err != nil {
+			return "", err
+		}
+		return "", grpcError
+	}
+	return string(lastKey), nil
+}
+
+// getPageSize returns the requested page size, or the default one when the
+// request does not set a limit.
+func getPageSize(limit *int32) (int32, error) {
+	if limit == nil {
+		return defaultPageSize, nil
+	}
+	if *limit <= 0 || *limit > maxPageSize {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("limit", "limit must be between 1 and 1000")
+		if err != nil {
+			return 0, err
+		}
+		return 0, grpcError
+	}
+	return *limit, nil
+}
+
+// decodeCollectionPageToken returns the id of the last collection of the previous
+// page, or types.NilUniqueID() when there is no page token.
+func decodeCollectionPageToken(pageToken string) (types.UniqueID, error) {
+	lastKey, err := decodePageToken(pageToken)
+	if err != nil || lastKey == "" {
+		return types.NilUniqueID(), err
+	}
+	collectionID, err := types.Parse(lastKey)
+	if err != nil {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("page_token", "malformed page token")
+		if err != nil {
+			return types.NilUniqueID(), err
+		}
+		return types.NilUniqueID(), grpcError
+	}
+	return collectionID, nil
+}
+
+// decodeCollectionVersionPageToken returns the last version of the previous page,
+// or 0 when there is no page token.
+func decodeCollectionVersionPageToken(pageToken string) (int32, error) {
+	lastKey, err := decodePageToken(pageToken)
+	if err != nil || lastKey == "" {
+		return 0, err
+	}
+	version, err := strconv.ParseInt(lastKey, 10, 32)
+	if err != nil || version <= 0 {
+		grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("page_token", "malformed page token")
+		if err != nil {
+			return 0, err
+		}
+		return 0, grpcError
+	}
+	return int32(version), nil
+}
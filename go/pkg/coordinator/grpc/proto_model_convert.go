@@ -140,6 +140,28 @@ func convertSegmentMetadataToModel(segmentMetadata *coordinatorpb.UpdateMetadata
 	return metadata, nil
 }
 
+func convertCollectionVersionToProto(version *model.CollectionVersion) *coordinatorpb.CollectionVersion {
+	segmentCompactionInfo := make([]*coordinatorpb.FlushSegmentCompactionInfo, 0, len(version.FlushSegmentCompactions))
+	for _, flushSegmentCompaction := range version.FlushSegmentCompactions {
+		filePaths := make(map[string]*coordinatorpb.FilePaths, len(flushSegmentCompaction.FilePaths))
+		for key, paths := range flushSegmentCompaction.FilePaths {
+			filePaths[key] = &coordinatorpb.FilePaths{
+				Paths: paths,
+			}
+		}
+		segmentCompactionInfo = append(segmentCompactionInfo, &coordinatorpb.FlushSegmentCompactionInfo{
+			SegmentId: flushSegmentCompaction.ID.String(),
+			FilePaths: filePaths,
+		})
+	}
+	return &coordinatorpb.CollectionVersion{
+		Version:               version.Version,
+		LogPosition:           version.LogPosition,
+		SegmentCompactionInfo: segmentCompactionInfo,
+		CreatedAt:             version.CreatedAt,
+	}
+}
+
 func convertSegmentToProto(segment *model.Segment) *coordinatorpb.Segment {
 	if segment == nil {
 		return nil
@@ -231,3 +253,137 @@ func convertSegmentToModel(segmentpb *coordinatorpb.Segment) (*model.CreateSegme
 		Metadata:     metadata,
 	}, nil
 }
+
+func convertWhereToModel(where *coordinatorpb.Where) (*model.Where, error) {
+	if where == nil {
+		return nil, nil
+	}
+
+	switch w := where.Where.(type) {
+	case *coordinatorpb.Where_DirectComparison:
+		comparison, err := convertDirectComparisonToModel(w.DirectComparison)
+		if err != nil {
+			return nil, err
+		}
+		return &model.Where{Comparison: comparison}, nil
+	case *coordinatorpb.Where_Children:
+		if len(w.Children.Children) == 0 {
+			return nil, common.ErrInvalidWhereClause
+		}
+		result := &model.Where{}
+		switch w.Children.Operator {
+		case coordinatorpb.BooleanOperator_AND:
+			result.Operator = model.BooleanOperatorAnd
+		case coordinatorpb.BooleanOperator_OR:
+			result.Operator = model.BooleanOperatorOr
+		default:
+			return nil, common.ErrInvalidWhereClause
+		}
+		for _, child := range w.Children.Children {
+			childWhere, err := convertWhereToModel(child)
+			if err != nil {
+				return nil, err
+			}
+			if childWhere == nil {
+				return nil, common.ErrInvalidWhereClause
+			}
+			result.Children = append(result.Children, childWhere)
+		}
+		return result, nil
+	default:
+		log.Error("where clause type not supported", zap.Any("where", where))
+		return nil, common.ErrInvalidWhereClause
+	}
+}
+
+func convertDirectComparisonToModel(comparison *coordinatorpb.DirectComparison) (*model.WhereComparison, error) {
+	result := &model.WhereComparison{
+		Key: comparison.Key,
+	}
+	var err error
+	switch c := comparison.Comparison.(type) {
+	case *coordinatorpb.DirectComparison_SingleStringOperand:
+		result.Operator, err = convertGenericComparatorToModel(c.SingleStringOperand.Comparator)
+		result.Values = []model.CollectionMetadataValueType{&model.CollectionMetadataValueStringType{Value: c.SingleStringOperand.Value}}
+	case *coordinatorpb.DirectComparison_StringListOperand:
+		result.Operator, err = convertListOperatorToModel(c.StringListOperand.ListOperator)
+		for _, value := range c.StringListOperand.Values {
+			result.Values = append(result.Values, &model.CollectionMetadataValueStringType{Value: value})
+		}
+	case *coordinatorpb.DirectComparison_SingleIntOperand:
+		switch comparator := c.SingleIntOperand.Comparator.(type) {
+		case *coordinatorpb.SingleIntComparison_GenericComparator:
+			result.Operator, err = convertGenericComparatorToModel(comparator.GenericComparator)
+		case *coordinatorpb.SingleIntComparison_NumberComparator:
+			result.Operator, err = convertNumberComparatorToModel(comparator.NumberComparator)
+		default:
+			err = common.ErrInvalidWhereClause
+		}
+		result.Values = []model.CollectionMetadataValueType{&model.CollectionMetadataValueInt64Type{Value: c.SingleIntOperand.Value}}
+	case *coordinatorpb.DirectComparison_IntListOperand:
+		result.Operator, err = convertListOperatorToModel(c.IntListOperand.ListOperator)
+		for _, value := range c.IntListOperand.Values {
+			result.Values = append(result.Values, &model.CollectionMetadataValueInt64Type{Value: value})
+		}
+	case *coordinatorpb.DirectComparison_SingleDoubleOperand:
+		switch comparator := c.SingleDoubleOperand.Comparator.(type) {
+		case *coordinatorpb.SingleDoubleComparison_GenericComparator:
+			result.Operator, err = convertGenericComparatorToModel(comparator.GenericComparator)
+		case *coordinatorpb.SingleDoubleComparison_NumberComparator:
+			result.Operator, err = convertNumberComparatorToModel(comparator.NumberComparator)
+		default:
+			err = common.ErrInvalidWhereClause
+		}
+		result.Values = []model.CollectionMetadataValueType{&model.CollectionMetadataValueFloat64Type{Value: c.SingleDoubleOperand.Value}}
+	case *coordinatorpb.DirectComparison_DoubleListOperand:
+		result.Operator, err = convertListOperatorToModel(c.DoubleListOperand.ListOperator)
+		for _, value := range c.DoubleListOperand.Values {
+			result.Values = append(result.Values, &model.CollectionMetadataValueFloat64Type{Value: value})
+		}
+	default:
+		err = common.ErrInvalidWhereClause
+	}
+	if err != nil {
+		log.Error("where comparison not supported", zap.Any("comparison", comparison))
+		return nil, err
+	}
+	if len(result.Values) == 0 {
+		log.Error("where comparison without values", zap.Any("comparison", comparison))
+		return nil, common.ErrInvalidWhereClause
+	}
+	return result, nil
+}
+
+func convertGenericComparatorToModel(comparator coordinatorpb.GenericComparator) (model.WhereOperator, error) {
+	switch comparator {
+	case coordinatorpb.GenericComparator_EQ:
+		return model.WhereOperatorEq, nil
+	case coordinatorpb.GenericComparator_NE:
+		return model.WhereOperatorNe, nil
+	}
+	return "", common.ErrInvalidWhereClause
+}
+
+func convertNumberComparatorToModel(comparator coordinatorpb.NumberComparator) (model.WhereOperator, error) {
+	switch comparator {
+	case coordinatorpb.NumberComparator_GT:
+		return model.WhereOperatorGt, nil
+	case coordinatorpb.NumberComparator_GTE:
+		return model.WhereOperatorGte, nil
+	case coordinatorpb.NumberComparator_LT:
+		return model.WhereOperatorLt, nil
+	case coordinatorpb.NumberComparator_LTE:
+		return model.WhereOperatorLte, nil
+	}
+	return "", common.ErrInvalidWhereClause
+}
+
+func convertListOperatorToModel(listOperator coordinatorpb.ListOperator) (model.WhereOperator, error) {
+	switch listOperator {
+	case coordinatorpb.ListOperator_IN:
+		return model.WhereOperatorIn, nil
+	case coordinatorpb.ListOperator_NIN:
+		return model.WhereOperatorNin, nil
+	}
+	return "", common.ErrInvalidWhereClause
+}
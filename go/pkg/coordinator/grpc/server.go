@@ -13,10 +13,12 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/notification"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/utils"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"gorm.io/gorm"
 )
@@ -54,6 +56,10 @@ type Config struct {
 	CompactionServiceMemberlistName string
 	CompactionServicePodLabel       string
 
+	// Log service config, the log offset of restored collections is not rewound
+	// when empty
+	LogServiceAddress string
+
 	// Config for testing
 	Testing bool
 }
@@ -64,9 +70,11 @@ type Config struct {
 // convenient for end-to-end property based testing.
 type Server struct {
 	coordinatorpb.UnimplementedSysDBServer
-	coordinator  coordinator.ICoordinator
-	grpcServer   grpcutils.GrpcServer
-	healthServer *health.Server
+	coordinator      coordinator.ICoordinator
+	logServiceConn   *grpc.ClientConn
+	logServiceClient logservicepb.LogServiceClient
+	grpcServer       grpcutils.GrpcServer
+	healthServer     *health.Server
 }
 
 func New(config Config) (*Server, error) {
@@ -109,12 +117,21 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 	} else {
 		return nil, errors.New("invalid notifier provider, only memory are supported")
 	}
+	notifier = &logDeletionNotifier{Notifier: notifier, server: s}
 	coordinator, err := coordinator.NewCoordinator(ctx, db, notificationStore, notifier)
 	if err != nil {
 		return nil, err
 	}
 	s.coordinator = coordinator
 	s.coordinator.Start()
+	if config.LogServiceAddress != "" {
+		conn, err := grpc.Dial(config.LogServiceAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+		s.logServiceConn = conn
+		s.logServiceClient = logservicepb.NewLogServiceClient(conn)
+	}
 	if !config.Testing {
 		namespace := config.KubernetesNamespace
 		// Create memberlist manager for query service
@@ -171,5 +188,8 @@ func createMemberlistManager(namespace string, memberlistName string, podLabel s
 func (s *Server) Close() error {
 	s.healthServer.Shutdown()
 	s.coordinator.Stop()
+	if s.logServiceConn != nil {
+		s.logServiceConn.Close()
+	}
 	return nil
 }
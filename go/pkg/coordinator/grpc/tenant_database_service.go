@@ -55,6 +55,67 @@ func (s *Server) GetDatabase(ctx context.Context, req *coordinatorpb.GetDatabase
 	return res, nil
 }
 
+func (s *Server) DeleteDatabase(ctx context.Context, req *coordinatorpb.DeleteDatabaseRequest) (*coordinatorpb.DeleteDatabaseResponse, error) {
+	res := &coordinatorpb.DeleteDatabaseResponse{}
+	deleteDatabase := &model.DeleteDatabase{
+		Name:   req.GetName(),
+		Tenant: req.GetTenant(),
+	}
+	err := s.coordinator.DeleteDatabase(ctx, deleteDatabase)
+	if err != nil {
+		log.Error("error DeleteDatabase", zap.Any("request", req), zap.Error(err))
+		if err == common.ErrDatabaseNotFound {
+			res.Status = failResponseWithError(err, 404)
+			return res, nil
+		}
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ListDatabases(ctx context.Context, req *coordinatorpb.ListDatabasesRequest) (*coordinatorpb.ListDatabasesResponse, error) {
+	res := &coordinatorpb.ListDatabasesResponse{}
+	pageSize, err := getPageSize(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	startAfter, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	// Fetch one more database than requested to know whether there is a next page.
+	listDatabases := &model.ListDatabases{
+		Tenant:     req.GetTenant(),
+		StartAfter: startAfter,
+		Limit:      pageSize + 1,
+	}
+	databases, err := s.coordinator.ListDatabases(ctx, listDatabases)
+	if err != nil {
+		log.Error("error ListDatabases", zap.Any("request", req), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			res.Status = failResponseWithError(err, 404)
+			return res, nil
+		}
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	if len(databases) > int(pageSize) {
+		databases = databases[:pageSize]
+		res.NextPageToken = encodePageToken(databases[len(databases)-1].Name)
+	}
+	for _, database := range databases {
+		res.Databases = append(res.Databases, &coordinatorpb.Database{
+			Id:     database.ID,
+			Name:   database.Name,
+			Tenant: database.Tenant,
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
 func (s *Server) CreateTenant(ctx context.Context, req *coordinatorpb.CreateTenantRequest) (*coordinatorpb.CreateTenantResponse, error) {
 	res := &coordinatorpb.CreateTenantResponse{}
 	createTenant := &model.CreateTenant{
@@ -94,6 +155,59 @@ func (s *Server) GetTenant(ctx context.Context, req *coordinatorpb.GetTenantRequ
 	return res, nil
 }
 
+func (s *Server) DeleteTenant(ctx context.Context, req *coordinatorpb.DeleteTenantRequest) (*coordinatorpb.DeleteTenantResponse, error) {
+	res := &coordinatorpb.DeleteTenantResponse{}
+	deleteTenant := &model.DeleteTenant{
+		Name: req.GetName(),
+	}
+	err := s.coordinator.DeleteTenant(ctx, deleteTenant)
+	if err != nil {
+		log.Error("error DeleteTenant", zap.Any("request", req), zap.Error(err))
+		if err == common.ErrTenantNotFound {
+			res.Status = failResponseWithError(err, 404)
+			return res, nil
+		}
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ListTenants(ctx context.Context, req *coordinatorpb.ListTenantsRequest) (*coordinatorpb.ListTenantsResponse, error) {
+	res := &coordinatorpb.ListTenantsResponse{}
+	pageSize, err := getPageSize(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	startAfter, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	// Fetch one more tenant than requested to know whether there is a next page.
+	listTenants := &model.ListTenants{
+		StartAfter: startAfter,
+		Limit:      pageSize + 1,
+	}
+	tenants, err := s.coordinator.ListTenants(ctx, listTenants)
+	if err != nil {
+		log.Error("error ListTenants", zap.Any("request", req), zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	if len(tenants) > int(pageSize) {
+		tenants = tenants[:pageSize]
+		res.NextPageToken = encodePageToken(tenants[len(tenants)-1].Name)
+	}
+	for _, tenant := range tenants {
+		res.Tenants = append(res.Tenants, &coordinatorpb.Tenant{
+			Name: tenant.Name,
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
 func (s *Server) SetLastCompactionTimeForTenant(ctx context.Context, req *coordinatorpb.SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error) {
 	err := s.coordinator.SetTenantLastCompactionTime(ctx, req.TenantLastCompactionTime.TenantId, req.TenantLastCompactionTime.LastCompactionTime)
 	if err != nil {
@@ -10,9 +10,12 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/metastore/coordinator"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	codes "google.golang.org/grpc/codes"
@@ -101,7 +104,155 @@ func (suite *TenantDatabaseServiceTestSuite) TestServer_TenantLastCompactionTime
 	suite.NoError(err)
 }
 
+func (suite *TenantDatabaseServiceTestSuite) TestServer_DeleteDatabaseAndTenant() {
+	log.Info("TestServer_DeleteDatabaseAndTenant")
+	tenantId := "TestDeleteDatabaseAndTenant"
+	databaseName := "TestDeleteDatabaseAndTenant"
+	databaseId, err := dao.CreateTestTenantAndDatabase(suite.db, tenantId, databaseName)
+	suite.NoError(err)
+	collectionId, err := dao.CreateTestCollection(suite.db, "TestDeleteDatabaseAndTenant", 128, databaseId)
+	suite.NoError(err)
+
+	// delete database cascades to collections and segments
+	deleteDatabaseResponse, err := suite.s.DeleteDatabase(context.Background(), &coordinatorpb.DeleteDatabaseRequest{
+		Name:   databaseName,
+		Tenant: tenantId,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), deleteDatabaseResponse.Status.Code)
+
+	getDatabaseResponse, err := suite.s.GetDatabase(context.Background(), &coordinatorpb.GetDatabaseRequest{
+		Name:   databaseName,
+		Tenant: tenantId,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(404), getDatabaseResponse.Status.Code)
+
+	collections, err := suite.catalog.GetCollections(context.Background(), types.MustParse(collectionId), nil, "", "", nil, nil, types.NilUniqueID(), nil)
+	suite.NoError(err)
+	suite.Empty(collections)
+	segments, err := suite.catalog.GetSegments(context.Background(), types.NilUniqueID(), nil, nil, types.MustParse(collectionId))
+	suite.NoError(err)
+	suite.Empty(segments)
+
+	var collection dbmodel.Collection
+	suite.NoError(suite.db.First(&collection, "id = ?", collectionId).Error)
+	suite.True(collection.IsDeleted)
+
+	var notifications []*dbmodel.Notification
+	suite.NoError(suite.db.Find(&notifications, "collection_id = ?", collectionId).Error)
+	suite.Len(notifications, 1)
+	suite.Equal(dbmodel.NotificationTypeDeleteCollection, notifications[0].Type)
+
+	deleteDatabaseResponse, err = suite.s.DeleteDatabase(context.Background(), &coordinatorpb.DeleteDatabaseRequest{
+		Name:   databaseName,
+		Tenant: tenantId,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(404), deleteDatabaseResponse.Status.Code)
+
+	// delete tenant
+	deleteTenantResponse, err := suite.s.DeleteTenant(context.Background(), &coordinatorpb.DeleteTenantRequest{
+		Name: tenantId,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(successCode), deleteTenantResponse.Status.Code)
+
+	getTenantResponse, err := suite.s.GetTenant(context.Background(), &coordinatorpb.GetTenantRequest{
+		Name: tenantId,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(404), getTenantResponse.Status.Code)
+
+	deleteTenantResponse, err = suite.s.DeleteTenant(context.Background(), &coordinatorpb.DeleteTenantRequest{
+		Name: tenantId,
+	})
+	suite.NoError(err)
+	suite.Equal(int32(404), deleteTenantResponse.Status.Code)
+
+	// clean up the soft deleted rows
+	suite.NoError(suite.db.Delete(&dbmodel.Notification{}, "collection_id = ?", collectionId).Error)
+	suite.NoError(suite.db.Delete(&dbmodel.Segment{}, "collection_id = ?", collectionId).Error)
+	suite.NoError(suite.db.Delete(&dbmodel.Collection{}, "id = ?", collectionId).Error)
+	suite.NoError(suite.db.Delete(&dbmodel.Database{}, "id = ?", databaseId).Error)
+	suite.NoError(suite.db.Delete(&dbmodel.Tenant{}, "id = ?", tenantId).Error)
+}
+
 func TestTenantDatabaseServiceTestSuite(t *testing.T) {
 	testSuite := new(TenantDatabaseServiceTestSuite)
 	suite.Run(t, testSuite)
 }
+
+func TestServer_ListTenantsAndDatabases(t *testing.T) {
+	s, err := NewWithGrpcProvider(Config{
+		SystemCatalogProvider:     "memory",
+		NotificationStoreProvider: "memory",
+		NotifierProvider:          "memory",
+		Testing:                   true}, grpcutils.Default, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	tenantNames := []string{"tenant1", "tenant2", "tenant3"}
+	for _, tenantName := range tenantNames {
+		res, err := s.CreateTenant(ctx, &coordinatorpb.CreateTenantRequest{Name: tenantName})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+	}
+	for _, databaseName := range []string{"database3", "database1", "database2"} {
+		res, err := s.CreateDatabase(ctx, &coordinatorpb.CreateDatabaseRequest{
+			Id:     types.NewUniqueID().String(),
+			Name:   databaseName,
+			Tenant: "tenant1",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+	}
+
+	// page through the tenants, the default tenant is listed first
+	limit := int32(2)
+	var listedTenants []string
+	pageToken := ""
+	for {
+		res, err := s.ListTenants(ctx, &coordinatorpb.ListTenantsRequest{Limit: &limit, PageToken: &pageToken})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(successCode), res.Status.Code)
+		assert.LessOrEqual(t, len(res.Tenants), int(limit))
+		for _, tenant := range res.Tenants {
+			listedTenants = append(listedTenants, tenant.Name)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		pageToken = res.NextPageToken
+	}
+	assert.Equal(t, append([]string{common.DefaultTenant}, tenantNames...), listedTenants)
+
+	res, err := s.ListDatabases(ctx, &coordinatorpb.ListDatabasesRequest{Tenant: "tenant1", Limit: &limit})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(successCode), res.Status.Code)
+	assert.Len(t, res.Databases, 2)
+	assert.Equal(t, "database1", res.Databases[0].Name)
+	assert.Equal(t, "database2", res.Databases[1].Name)
+	assert.NotEmpty(t, res.NextPageToken)
+
+	res, err = s.ListDatabases(ctx, &coordinatorpb.ListDatabasesRequest{Tenant: "tenant1", Limit: &limit, PageToken: &res.NextPageToken})
+	assert.NoError(t, err)
+	assert.Len(t, res.Databases, 1)
+	assert.Equal(t, "database3", res.Databases[0].Name)
+	assert.Empty(t, res.NextPageToken)
+
+	res, err = s.ListDatabases(ctx, &coordinatorpb.ListDatabasesRequest{Tenant: "tenant2"})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Databases)
+
+	res, err = s.ListDatabases(ctx, &coordinatorpb.ListDatabasesRequest{Tenant: "missing"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(404), res.Status.Code)
+
+	malformed := "not base64!"
+	_, err = s.ListTenants(ctx, &coordinatorpb.ListTenantsRequest{PageToken: &malformed})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	invalidLimit := int32(0)
+	_, err = s.ListTenants(ctx, &coordinatorpb.ListTenantsRequest{Limit: &invalidLimit})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -1,12 +1,15 @@
 package grpcutils
 
 import (
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func BuildInvalidArgumentGrpcError(fieldName string, desc string) (error, error) {
@@ -27,6 +30,52 @@ func BuildInvalidArgumentGrpcError(fieldName string, desc string) (error, error)
 	return st.Err(), nil
 }
 
+// BuildResourceExhaustedGrpcError builds a RESOURCE_EXHAUSTED error telling the
+// client which quota of subject was exceeded and how long to wait before retrying.
+func BuildResourceExhaustedGrpcError(subject string, desc string, retryAfter time.Duration) (error, error) {
+	log.Info("ResourceExhausted", zap.String("subject", subject), zap.String("desc", desc))
+	st := status.New(codes.ResourceExhausted, desc)
+	qf := &errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{
+			{
+				Subject:     subject,
+				Description: desc,
+			},
+		},
+	}
+	ri := &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	}
+	st, err := st.WithDetails(qf, ri)
+	if err != nil {
+		log.Error("Unexpected error attaching metadata", zap.Error(err))
+		return nil, err
+	}
+	return st.Err(), nil
+}
+
+// BuildFailedPreconditionGrpcError builds a FAILED_PRECONDITION error telling the
+// client which precondition of subject does not hold.
+func BuildFailedPreconditionGrpcError(violationType string, subject string, desc string) (error, error) {
+	log.Info("FailedPrecondition", zap.String("type", violationType), zap.String("subject", subject), zap.String("desc", desc))
+	st := status.New(codes.FailedPrecondition, desc)
+	pf := &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{
+				Type:        violationType,
+				Subject:     subject,
+				Description: desc,
+			},
+		},
+	}
+	st, err := st.WithDetails(pf)
+	if err != nil {
+		log.Error("Unexpected error attaching metadata", zap.Error(err))
+		return nil, err
+	}
+	return st.Err(), nil
+}
+
 func BuildInternalGrpcError(msg string) error {
 	return status.Error(codes.Internal, msg)
 }
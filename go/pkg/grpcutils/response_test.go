@@ -0,0 +1,55 @@
+package grpcutils
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBuildResourceExhaustedGrpcError(t *testing.T) {
+	grpcError, err := BuildResourceExhaustedGrpcError("collection:c1", "too many records", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	st := status.Convert(grpcError)
+	if st.Code() != codes.ResourceExhausted {
+		t.Errorf("Expected %v, got %v", codes.ResourceExhausted, st.Code())
+	}
+	var retryInfo *errdetails.RetryInfo
+	var quotaFailure *errdetails.QuotaFailure
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.RetryInfo:
+			retryInfo = d
+		case *errdetails.QuotaFailure:
+			quotaFailure = d
+		}
+	}
+	if retryInfo == nil || retryInfo.RetryDelay.AsDuration() != 5*time.Second {
+		t.Errorf("Expected a retry delay of 5s, got %v", retryInfo)
+	}
+	if quotaFailure == nil || len(quotaFailure.Violations) != 1 || quotaFailure.Violations[0].Subject != "collection:c1" {
+		t.Errorf("Expected a quota violation of collection:c1, got %v", quotaFailure)
+	}
+}
+
+func TestBuildFailedPreconditionGrpcError(t *testing.T) {
+	grpcError, err := BuildFailedPreconditionGrpcError("OFFSET", "c1", "offset moved")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	st := status.Convert(grpcError)
+	if st.Code() != codes.FailedPrecondition {
+		t.Errorf("Expected %v, got %v", codes.FailedPrecondition, st.Code())
+	}
+	if len(st.Details()) != 1 {
+		t.Fatalf("Expected 1 detail, got %v", st.Details())
+	}
+	preconditionFailure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	if !ok || preconditionFailure.Violations[0].Type != "OFFSET" || preconditionFailure.Violations[0].Subject != "c1" {
+		t.Errorf("Expected an OFFSET violation of c1, got %v", st.Details()[0])
+	}
+}
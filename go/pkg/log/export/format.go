@@ -0,0 +1,100 @@
+// Package export reads and writes the files of the log of a collection exported
+// by the log service. The records are LogRecord messages, with the offset and
+// timestamp they had in the log and their OperationRecord.
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type Format string
+
+const (
+	// FormatProtobuf is length-delimited protobuf, each record prefixed by its
+	// varint size
+	FormatProtobuf Format = "protobuf"
+	// FormatNDJSON is a JSON record per line
+	FormatNDJSON Format = "ndjson"
+)
+
+// maxRecordSize bounds the size of a record read from a file.
+const maxRecordSize = 64 << 20
+
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatProtobuf, FormatNDJSON:
+		return Format(format), nil
+	default:
+		return "", fmt.Errorf("unknown export format %q, expected %q or %q", format, FormatProtobuf, FormatNDJSON)
+	}
+}
+
+type Writer struct {
+	format Format
+	w      *bufio.Writer
+}
+
+func NewWriter(w io.Writer, format Format) *Writer {
+	return &Writer{format: format, w: bufio.NewWriter(w)}
+}
+
+func (w *Writer) Write(record *logservicepb.LogRecord) error {
+	if w.format == FormatNDJSON {
+		data, err := protojson.Marshal(record)
+		if err != nil {
+			return err
+		}
+		if _, err = w.w.Write(data); err != nil {
+			return err
+		}
+		return w.w.WriteByte('\n')
+	}
+	_, err := protodelim.MarshalTo(w.w, record)
+	return err
+}
+
+// Flush writes the buffered records to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+type Reader struct {
+	format Format
+	r      *bufio.Reader
+}
+
+func NewReader(r io.Reader, format Format) *Reader {
+	return &Reader{format: format, r: bufio.NewReader(r)}
+}
+
+// Read returns the next record, or io.EOF after the last one.
+func (r *Reader) Read() (*logservicepb.LogRecord, error) {
+	record := &logservicepb.LogRecord{}
+	if r.format == FormatNDJSON {
+		for {
+			line, err := r.r.ReadBytes('\n')
+			if len(line) == 0 || (err != nil && err != io.EOF) {
+				return nil, err
+			}
+			if len(line) == 1 && line[0] == '\n' {
+				// skip the blank lines
+				continue
+			}
+			if err = protojson.Unmarshal(line, record); err != nil {
+				return nil, err
+			}
+			return record, nil
+		}
+	}
+	err := protodelim.UnmarshalOptions{MaxSize: maxRecordSize}.UnmarshalFrom(r.r, record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
@@ -0,0 +1,62 @@
+package export
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFormat_RoundTrip(t *testing.T) {
+	records := []*logservicepb.LogRecord{
+		{
+			LogOffset: 1,
+			Timestamp: 1000,
+			Record: &coordinatorpb.OperationRecord{
+				Id: "id1",
+				Vector: &coordinatorpb.Vector{
+					Dimension: 2,
+					Vector:    []byte{1, 2, 3, 4, 5, 6, 7, 8},
+					Encoding:  coordinatorpb.ScalarEncoding_FLOAT32,
+				},
+				Operation: coordinatorpb.Operation_ADD,
+			},
+		},
+		{
+			LogOffset: 2,
+			Timestamp: 2000,
+			Record:    &coordinatorpb.OperationRecord{Id: "id2", Operation: coordinatorpb.Operation_DELETE},
+		},
+	}
+	for _, format := range []Format{FormatProtobuf, FormatNDJSON} {
+		var buf bytes.Buffer
+		writer := NewWriter(&buf, format)
+		for _, record := range records {
+			assert.NoError(t, writer.Write(record))
+		}
+		assert.NoError(t, writer.Flush())
+
+		reader := NewReader(&buf, format)
+		for _, expected := range records {
+			record, err := reader.Read()
+			if !assert.NoError(t, err, format) {
+				break
+			}
+			assert.True(t, proto.Equal(expected, record), format)
+		}
+		_, err := reader.Read()
+		assert.ErrorIs(t, err, io.EOF, format)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("ndjson")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatNDJSON, format)
+	_, err = ParseFormat("csv")
+	assert.Error(t, err)
+}
@@ -0,0 +1,168 @@
+package purger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// RecordPurger deletes compacted records and expired push keys from the log, see
+// repository.LogStore.PurgeRecordsBatch and PurgeIdempotencyKeysBatch.
+type RecordPurger interface {
+	PurgeRecordsBatch(ctx context.Context, batchSize int32, defaultRetention repository.Retention) (int64, error)
+	PurgeIdempotencyKeysBatch(ctx context.Context, batchSize int32) (int64, error)
+}
+
+type Config struct {
+	// Interval between two purge runs
+	Interval time.Duration
+	// BatchSize is the number of records or push keys deleted by one statement, it
+	// bounds how long the statement holds its locks
+	BatchSize int32
+	// MaxBatchesPerRun bounds the work of a single run on the records and on the push
+	// keys, the rest is left to the next run. There is no bound when it is 0.
+	MaxBatchesPerRun int
+	// DefaultRetention applies to the collections without a retention of their own
+	DefaultRetention repository.Retention
+}
+
+// Purger periodically deletes the records of the log that have been compacted and
+// are out of the retention window of their collection, and the push keys that are
+// out of the idempotency window.
+type Purger struct {
+	recordPurger RecordPurger
+	config       Config
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+
+	purgedRecords         metric.Int64Counter
+	purgedIdempotencyKeys metric.Int64Counter
+	runs                  metric.Int64Counter
+	failedRuns            metric.Int64Counter
+	runDuration           metric.Float64Histogram
+}
+
+var _ common.Component = (*Purger)(nil)
+
+func NewPurger(recordPurger RecordPurger, config Config) (*Purger, error) {
+	meter := otel.Meter("chroma.logservice.purger")
+	purgedRecords, err := meter.Int64Counter("log_purger_purged_records", metric.WithDescription("Number of records deleted by the log purger"))
+	if err != nil {
+		return nil, err
+	}
+	purgedIdempotencyKeys, err := meter.Int64Counter("log_purger_purged_idempotency_keys", metric.WithDescription("Number of push idempotency keys deleted by the log purger"))
+	if err != nil {
+		return nil, err
+	}
+	runs, err := meter.Int64Counter("log_purger_runs", metric.WithDescription("Number of log purger runs"))
+	if err != nil {
+		return nil, err
+	}
+	failedRuns, err := meter.Int64Counter("log_purger_failed_runs", metric.WithDescription("Number of log purger runs that stopped on an error"))
+	if err != nil {
+		return nil, err
+	}
+	runDuration, err := meter.Float64Histogram("log_purger_run_duration", metric.WithDescription("Duration of the log purger runs"), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+	return &Purger{
+		recordPurger:          recordPurger,
+		config:                config,
+		stopCh:                make(chan struct{}),
+		purgedRecords:         purgedRecords,
+		purgedIdempotencyKeys: purgedIdempotencyKeys,
+		runs:                  runs,
+		failedRuns:            failedRuns,
+		runDuration:           runDuration,
+	}, nil
+}
+
+func (p *Purger) Start() error {
+	log.Info("Starting log purger", zap.Duration("interval", p.config.Interval), zap.Int32("batchSize", p.config.BatchSize))
+	p.wg.Add(1)
+	go p.run()
+	return nil
+}
+
+// Stop waits for the batch being deleted to finish, the rest of the run is left
+// to the next start.
+func (p *Purger) Stop() error {
+	close(p.stopCh)
+	p.wg.Wait()
+	log.Info("Stopped log purger")
+	return nil
+}
+
+func (p *Purger) run() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			p.purge(context.Background())
+		}
+	}
+}
+
+// purge deletes the records and then the push keys in batches, see purgeBatches.
+func (p *Purger) purge(ctx context.Context) {
+	start := time.Now()
+	var records, idempotencyKeys int64
+	defer func() {
+		p.runs.Add(ctx, 1)
+		p.runDuration.Record(ctx, time.Since(start).Seconds())
+		log.Info("log purger run done", zap.Int64("purgedRecords", records), zap.Int64("purgedIdempotencyKeys", idempotencyKeys), zap.Duration("duration", time.Since(start)))
+	}()
+	var err error
+	records, err = p.purgeBatches(ctx, p.purgedRecords, func(ctx context.Context) (int64, error) {
+		return p.recordPurger.PurgeRecordsBatch(ctx, p.config.BatchSize, p.config.DefaultRetention)
+	})
+	if err != nil {
+		log.Error("failed to purge log records", zap.Error(err))
+		p.failedRuns.Add(ctx, 1)
+		return
+	}
+	idempotencyKeys, err = p.purgeBatches(ctx, p.purgedIdempotencyKeys, func(ctx context.Context) (int64, error) {
+		return p.recordPurger.PurgeIdempotencyKeysBatch(ctx, p.config.BatchSize)
+	})
+	if err != nil {
+		log.Error("failed to purge push idempotency keys", zap.Error(err))
+		p.failedRuns.Add(ctx, 1)
+	}
+}
+
+// purgeBatches deletes batches until there is nothing left to delete, the run
+// reached MaxBatchesPerRun or the purger is stopped, and returns how much it
+// deleted.
+func (p *Purger) purgeBatches(ctx context.Context, purged metric.Int64Counter, purgeBatch func(context.Context) (int64, error)) (int64, error) {
+	var total int64
+	for batch := 0; p.config.MaxBatchesPerRun == 0 || batch < p.config.MaxBatchesPerRun; batch++ {
+		select {
+		case <-p.stopCh:
+			return total, nil
+		default:
+		}
+		count, err := purgeBatch(ctx)
+		if err != nil {
+			return total, err
+		}
+		total += count
+		purged.Add(ctx, count)
+		if count < int64(p.config.BatchSize) {
+			return total, nil
+		}
+	}
+	return total, nil
+}
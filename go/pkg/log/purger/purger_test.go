@@ -0,0 +1,123 @@
+package purger
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRecordPurger struct {
+	mu        sync.Mutex
+	remaining int64
+	calls     int
+	err       error
+	retention repository.Retention
+
+	remainingKeys int64
+	keyCalls      int
+}
+
+func (f *fakeRecordPurger) PurgeRecordsBatch(ctx context.Context, batchSize int32, defaultRetention repository.Retention) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	f.retention = defaultRetention
+	if f.err != nil {
+		return 0, f.err
+	}
+	count := int64(batchSize)
+	if f.remaining < count {
+		count = f.remaining
+	}
+	f.remaining -= count
+	return count, nil
+}
+
+func (f *fakeRecordPurger) PurgeIdempotencyKeysBatch(ctx context.Context, batchSize int32) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keyCalls++
+	count := int64(batchSize)
+	if f.remainingKeys < count {
+		count = f.remainingKeys
+	}
+	f.remainingKeys -= count
+	return count, nil
+}
+
+func TestPurger_PurgeInBatches(t *testing.T) {
+	recordPurger := &fakeRecordPurger{remaining: 25}
+	retention := repository.Retention{PeriodSeconds: 60, RecordCount: 10}
+	p, err := NewPurger(recordPurger, Config{Interval: time.Hour, BatchSize: 10, DefaultRetention: retention})
+	assert.NoError(t, err)
+
+	// the run stops at the first batch that is not full
+	p.purge(context.Background())
+	assert.Equal(t, 3, recordPurger.calls)
+	assert.Equal(t, int64(0), recordPurger.remaining)
+	assert.Equal(t, retention, recordPurger.retention)
+}
+
+func TestPurger_PurgeIdempotencyKeys(t *testing.T) {
+	recordPurger := &fakeRecordPurger{remaining: 5, remainingKeys: 35}
+	p, err := NewPurger(recordPurger, Config{Interval: time.Hour, BatchSize: 10, MaxBatchesPerRun: 3})
+	assert.NoError(t, err)
+
+	// the keys are purged after the records, with their own batch bound
+	p.purge(context.Background())
+	assert.Equal(t, 1, recordPurger.calls)
+	assert.Equal(t, 3, recordPurger.keyCalls)
+	assert.Equal(t, int64(5), recordPurger.remainingKeys)
+	p.purge(context.Background())
+	assert.Equal(t, 4, recordPurger.keyCalls)
+	assert.Equal(t, int64(0), recordPurger.remainingKeys)
+}
+
+func TestPurger_MaxBatchesPerRun(t *testing.T) {
+	recordPurger := &fakeRecordPurger{remaining: 100}
+	p, err := NewPurger(recordPurger, Config{Interval: time.Hour, BatchSize: 10, MaxBatchesPerRun: 2})
+	assert.NoError(t, err)
+
+	p.purge(context.Background())
+	assert.Equal(t, 2, recordPurger.calls)
+	assert.Equal(t, int64(80), recordPurger.remaining)
+}
+
+func TestPurger_Error(t *testing.T) {
+	recordPurger := &fakeRecordPurger{remaining: 100, err: errors.New("connection refused")}
+	p, err := NewPurger(recordPurger, Config{Interval: time.Hour, BatchSize: 10})
+	assert.NoError(t, err)
+
+	// the run gives up and the next one tries again
+	p.purge(context.Background())
+	assert.Equal(t, 1, recordPurger.calls)
+	assert.Equal(t, 0, recordPurger.keyCalls)
+}
+
+func TestPurger_StartStop(t *testing.T) {
+	recordPurger := &fakeRecordPurger{remaining: 100}
+	p, err := NewPurger(recordPurger, Config{Interval: 10 * time.Millisecond, BatchSize: 10})
+	assert.NoError(t, err)
+
+	assert.NoError(t, p.Start())
+	assert.Eventually(t, func() bool {
+		recordPurger.mu.Lock()
+		defer recordPurger.mu.Unlock()
+		return recordPurger.remaining == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.NoError(t, p.Stop())
+
+	// nothing runs once stopped
+	recordPurger.mu.Lock()
+	calls := recordPurger.calls
+	recordPurger.mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	recordPurger.mu.Lock()
+	assert.Equal(t, calls, recordPurger.calls)
+	recordPurger.mu.Unlock()
+}
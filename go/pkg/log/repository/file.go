@@ -0,0 +1,1393 @@
+package repository
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	log "github.com/chroma-core/chroma/go/database/log/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// FsyncPolicy is when FileLogStore flushes the appended records to the disk.
+type FsyncPolicy string
+
+const (
+	// FsyncAlways flushes every push before it is acknowledged
+	FsyncAlways FsyncPolicy = "always"
+	// FsyncInterval flushes the pushes periodically, a crash loses the pushes of
+	// the last interval
+	FsyncInterval FsyncPolicy = "interval"
+	// FsyncNever leaves the flushes to the operating system
+	FsyncNever FsyncPolicy = "never"
+)
+
+const (
+	defaultSegmentSize   = 64 << 20
+	defaultFsyncInterval = time.Second
+
+	segmentFileExt   = ".log"
+	metaFileName     = "meta.json"
+	tombstoneDirName = "tombstones"
+
+	// frameHeaderSize is the payload length and checksum before every frame
+	frameHeaderSize = 8
+	// framePayloadHeaderSize is the offset, timestamp, push length and key length
+	// at the start of the payload
+	framePayloadHeaderSize = 22
+	// indexInterval is the number of records between two entries of the offset
+	// index of a segment
+	indexInterval = 64
+)
+
+var errCorruptFrame = errors.New("corrupt log frame")
+
+type FileLogStoreConfig struct {
+	// Dir holds a directory per collection
+	Dir         string
+	FsyncPolicy FsyncPolicy
+	// FsyncInterval is the flush period of FsyncInterval
+	FsyncInterval time.Duration
+	// SegmentSize is the size after which the next push starts a new segment
+	SegmentSize int64
+}
+
+// frame is a record as written in a segment file:
+//
+//	uint32 payload length | uint32 crc32c of the payload | payload
+//	payload: int64 offset | int64 timestamp | int32 push length | uint16 key length | key | record
+//
+// The first record of a push carries the number of records of the push and its
+// idempotency key, the others have a push length of 0 and no key.
+type frame struct {
+	offset         int64
+	timestamp      int64
+	pushLength     int32
+	idempotencyKey string
+	record         []byte
+	// size is the size of the frame in the segment when it is read
+	size int64
+	// corrupt is set on a frame failing its checksum, only its offset and size
+	// are known
+	corrupt bool
+}
+
+// recordLength is the length of the record, the one of a corrupt frame includes
+// its idempotency key.
+func (f frame) recordLength() int64 {
+	if f.corrupt {
+		return f.size - frameHeaderSize - framePayloadHeaderSize
+	}
+	return int64(len(f.record))
+}
+
+func appendFrame(buf []byte, f frame) []byte {
+	start := len(buf)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(framePayloadHeaderSize+len(f.idempotencyKey)+len(f.record)))
+	buf = binary.LittleEndian.AppendUint32(buf, 0)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(f.offset))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(f.timestamp))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(f.pushLength))
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(f.idempotencyKey)))
+	buf = append(buf, f.idempotencyKey...)
+	buf = append(buf, f.record...)
+	binary.LittleEndian.PutUint32(buf[start+4:], crc32.Checksum(buf[start+frameHeaderSize:], crcTable))
+	return buf
+}
+
+// readFrame reads the frame at position of a segment of size end. It returns
+// io.EOF at the end of the segment, io.ErrUnexpectedEOF for a frame cut short and
+// errCorruptFrame for a frame that can not be decoded. A frame failing its
+// checksum still has its size, so that the next frames can be read.
+func readFrame(r io.ReaderAt, position int64, end int64) (frame, error) {
+	if position == end {
+		return frame{}, io.EOF
+	}
+	if position+frameHeaderSize > end {
+		return frame{}, io.ErrUnexpectedEOF
+	}
+	var header [frameHeaderSize]byte
+	if _, err := r.ReadAt(header[:], position); err != nil {
+		return frame{}, err
+	}
+	payloadLength := int64(binary.LittleEndian.Uint32(header[0:]))
+	if payloadLength < framePayloadHeaderSize {
+		return frame{}, errCorruptFrame
+	}
+	if position+frameHeaderSize+payloadLength > end {
+		return frame{}, io.ErrUnexpectedEOF
+	}
+	payload := make([]byte, payloadLength)
+	if _, err := r.ReadAt(payload, position+frameHeaderSize); err != nil {
+		return frame{}, err
+	}
+	if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:]) {
+		return frame{size: frameHeaderSize + payloadLength}, errCorruptFrame
+	}
+	keyLength := int64(binary.LittleEndian.Uint16(payload[20:]))
+	if framePayloadHeaderSize+keyLength > payloadLength {
+		return frame{}, errCorruptFrame
+	}
+	return frame{
+		offset:         int64(binary.LittleEndian.Uint64(payload[0:])),
+		timestamp:      int64(binary.LittleEndian.Uint64(payload[8:])),
+		pushLength:     int32(binary.LittleEndian.Uint32(payload[16:])),
+		idempotencyKey: string(payload[framePayloadHeaderSize : framePayloadHeaderSize+keyLength]),
+		record:         payload[framePayloadHeaderSize+keyLength:],
+		size:           frameHeaderSize + payloadLength,
+	}, nil
+}
+
+type indexEntry struct {
+	offset   int64
+	position int64
+	// bytesBefore is the size of the records before offset, counted from the first
+	// record on disk when the store was opened
+	bytesBefore int64
+}
+
+// segment is a file of consecutive records, named after the offset of its first
+// record. Only the last segment of a collection is appended to.
+type segment struct {
+	firstOffset int64
+	// lastOffset is firstOffset-1 while the segment is empty
+	lastOffset int64
+	path       string
+	file       *os.File
+	size       int64
+	// index has an entry every indexInterval records from the first one
+	index []indexEntry
+	// dirty is set when appended records are not flushed yet
+	dirty atomic.Bool
+}
+
+// seek returns the position and offset of the indexed record closest before
+// offset.
+func (seg *segment) seek(offset int64) (int64, int64) {
+	i := sort.Search(len(seg.index), func(i int) bool {
+		return seg.index[i].offset > offset
+	}) - 1
+	if i < 0 {
+		return 0, seg.firstOffset
+	}
+	return seg.index[i].position, seg.index[i].offset
+}
+
+// fileCollectionMeta is the state of a collection that is not in its records.
+type fileCollectionMeta struct {
+	CompactionOffset       int64   `json:"compaction_offset"`
+	StartOffset            int64   `json:"start_offset"`
+	TenantID               *string `json:"tenant_id,omitempty"`
+	RetentionPeriodSeconds *int64  `json:"retention_period_seconds,omitempty"`
+	RetentionRecordCount   *int64  `json:"retention_record_count,omitempty"`
+	// CompactionOffsets are the compaction offsets set in the restore window
+	CompactionOffsets []compactionOffset `json:"compaction_offsets,omitempty"`
+	// GapSegments are the first offsets of the segments started after offsets lost
+	// in a crash
+	GapSegments []int64 `json:"gap_segments,omitempty"`
+}
+
+type fileCollection struct {
+	mu sync.RWMutex
+	log.Collection
+	dir string
+	// startOffset is the first record that is not purged. The purged records are
+	// skipped and their segments deleted once all their records are purged.
+	startOffset int64
+	segments    []*segment
+	// gapSegments are the first offsets of the segments that do not follow the one
+	// before them, the records in between were lost in a crash
+	gapSegments []int64
+	// compactionOffsets are the compaction offsets set in the restore window, the
+	// purger keeps the records after them
+	compactionOffsets []compactionOffset
+	// totalBytes is the size of the records on disk, in the unit of
+	// indexEntry.bytesBefore
+	totalBytes      int64
+	idempotencyKeys map[string]log.PushIdempotencyKey
+	// deleted is set by DeleteCollection for the callers waiting on the lock
+	deleted bool
+}
+
+func (c *fileCollection) meta() fileCollectionMeta {
+	meta := fileCollectionMeta{
+		CompactionOffset: c.RecordCompactionOffsetPosition,
+		StartOffset:      c.startOffset,
+		// copies, so that the meta does not change with the collection
+		CompactionOffsets: slices.Clone(c.compactionOffsets),
+	}
+	if tenantId := c.TenantID.String; c.TenantID.Valid {
+		meta.TenantID = &tenantId
+	}
+	if periodSeconds := c.RetentionPeriodSeconds.Int64; c.RetentionPeriodSeconds.Valid {
+		meta.RetentionPeriodSeconds = &periodSeconds
+	}
+	if recordCount := c.RetentionRecordCount.Int64; c.RetentionRecordCount.Valid {
+		meta.RetentionRecordCount = &recordCount
+	}
+	for _, firstOffset := range c.gapSegments {
+		// the gaps before the first segment are purged
+		if len(c.segments) > 0 && firstOffset > c.segments[0].firstOffset {
+			meta.GapSegments = append(meta.GapSegments, firstOffset)
+		}
+	}
+	return meta
+}
+
+func (c *fileCollection) setMeta(meta fileCollectionMeta) {
+	c.RecordCompactionOffsetPosition = meta.CompactionOffset
+	c.startOffset = max(meta.StartOffset, 1)
+	c.compactionOffsets = slices.Clone(meta.CompactionOffsets)
+	c.TenantID = pgtype.Text{}
+	if meta.TenantID != nil {
+		c.TenantID = pgtype.Text{String: *meta.TenantID, Valid: true}
+	}
+	c.RetentionPeriodSeconds = pgtype.Int8{}
+	if meta.RetentionPeriodSeconds != nil {
+		c.RetentionPeriodSeconds = pgtype.Int8{Int64: *meta.RetentionPeriodSeconds, Valid: true}
+	}
+	c.RetentionRecordCount = pgtype.Int8{}
+	if meta.RetentionRecordCount != nil {
+		c.RetentionRecordCount = pgtype.Int8{Int64: *meta.RetentionRecordCount, Valid: true}
+	}
+	c.gapSegments = meta.GapSegments
+}
+
+// scanFrames calls visit on the frames from offset on until it returns false. A
+// frame failing its checksum is visited as corrupt.
+func (c *fileCollection) scanFrames(offset int64, visit func(frame) bool) error {
+	if offset > c.RecordEnumerationOffsetPosition {
+		return nil
+	}
+	i := sort.Search(len(c.segments), func(i int) bool {
+		return c.segments[i].firstOffset > offset
+	}) - 1
+	for i = max(i, 0); i < len(c.segments); i++ {
+		seg := c.segments[i]
+		position, next := seg.seek(offset)
+		for position < seg.size {
+			f, err := readFrame(seg.file, position, seg.size)
+			if errors.Is(err, errCorruptFrame) && f.size > 0 {
+				f = frame{offset: next, size: f.size, corrupt: true}
+			} else if err != nil {
+				return fmt.Errorf("failed to read %s at %d: %w", seg.path, position, err)
+			}
+			position += f.size
+			next = f.offset + 1
+			if f.offset < offset {
+				continue
+			}
+			if !visit(f) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// scan calls visit on the records from offset on until it returns false. A record
+// failing its checksum is a ChecksumError.
+func (c *fileCollection) scan(offset int64, visit func(frame) bool) error {
+	var checksumErr error
+	err := c.scanFrames(offset, func(f frame) bool {
+		if f.corrupt {
+			checksumErr = &ChecksumError{CollectionID: c.ID, Offset: f.offset}
+			return false
+		}
+		return visit(f)
+	})
+	if err != nil {
+		return err
+	}
+	return checksumErr
+}
+
+// bytesBefore returns the size of the records on disk before offset.
+func (c *fileCollection) bytesBefore(offset int64) (int64, error) {
+	if offset > c.RecordEnumerationOffsetPosition || len(c.segments) == 0 {
+		return c.totalBytes, nil
+	}
+	i := sort.Search(len(c.segments), func(i int) bool {
+		return c.segments[i].firstOffset > offset
+	}) - 1
+	seg := c.segments[max(i, 0)]
+	if len(seg.index) == 0 {
+		return c.totalBytes, nil
+	}
+	j := sort.Search(len(seg.index), func(j int) bool {
+		return seg.index[j].offset > offset
+	}) - 1
+	if j < 0 {
+		// offset is before the first record on disk
+		return seg.index[0].bytesBefore, nil
+	}
+	bytes := seg.index[j].bytesBefore
+	err := c.scanFrames(seg.index[j].offset, func(f frame) bool {
+		if f.offset >= offset {
+			return false
+		}
+		bytes += f.recordLength()
+		return true
+	})
+	return bytes, err
+}
+
+// uncompactedBytes returns the size of the records after the compaction offset.
+func (c *fileCollection) uncompactedBytes(compactionOffset int64) (int64, error) {
+	before, err := c.bytesBefore(max(compactionOffset+1, c.startOffset))
+	if err != nil {
+		return 0, err
+	}
+	return c.totalBytes - before, nil
+}
+
+// appendIndex records a frame written at position of the last segment.
+func (c *fileCollection) appendIndex(seg *segment, f frame, position int64) {
+	if (f.offset-seg.firstOffset)%indexInterval == 0 {
+		seg.index = append(seg.index, indexEntry{
+			offset:      f.offset,
+			position:    position,
+			bytesBefore: c.totalBytes,
+		})
+	}
+	c.totalBytes += f.recordLength()
+	seg.lastOffset = f.offset
+	if f.pushLength > 0 && f.idempotencyKey != "" {
+		c.idempotencyKeys[f.idempotencyKey] = log.PushIdempotencyKey{
+			CollectionID:   c.ID,
+			IdempotencyKey: f.idempotencyKey,
+			FirstOffset:    f.offset,
+			RecordCount:    int64(f.pushLength),
+			Timestamp:      f.timestamp,
+		}
+	}
+}
+
+type usage struct {
+	records int64
+	bytes   int64
+}
+
+// FileLogStore keeps the logs in append-only files on the local disk, for single
+// node deployments. It has the ordering and offset guarantees of LogRepository.
+//
+// Every collection has a directory with its state in meta.json and its records in
+// segment files. A push is written to the last segment in one write and is
+// acknowledged once flushed as the FsyncPolicy says. When the store opens, it
+// reads every segment, checks the checksums and cuts the last segment after its
+// last complete push, so a crash never leaves part of a push. A corrupt record
+// anywhere else fails the opening.
+//
+// The records are found through a sparse index of the segments kept in memory.
+// Purging moves the start of the log and deletes the segments before it.
+type FileLogStore struct {
+	config FileLogStoreConfig
+	// idempotencyWindow is how long the key of a push is remembered
+	idempotencyWindow time.Duration
+	// restoreWindow is how long the records a version needs are kept after it
+	restoreWindow time.Duration
+	quota         Quota
+
+	mu          sync.RWMutex
+	collections map[string]*fileCollection
+	// tombstones are the deletion times of the deleted collections
+	tombstones map[string]int64
+
+	// usageMu guards tenantUsage, it is taken after the lock of a collection
+	usageMu     sync.Mutex
+	tenantUsage map[string]usage
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewFileLogStore opens the logs in config.Dir, creating it if needed. Close
+// flushes and closes the files.
+func NewFileLogStore(config FileLogStoreConfig, idempotencyWindow time.Duration, restoreWindow time.Duration, quota Quota) (*FileLogStore, error) {
+	if config.Dir == "" {
+		return nil, errors.New("the directory of the file log store is not set")
+	}
+	switch config.FsyncPolicy {
+	case "":
+		config.FsyncPolicy = FsyncAlways
+	case FsyncAlways, FsyncInterval, FsyncNever:
+	default:
+		return nil, fmt.Errorf("unknown fsync policy %q", config.FsyncPolicy)
+	}
+	if config.FsyncInterval <= 0 {
+		config.FsyncInterval = defaultFsyncInterval
+	}
+	if config.SegmentSize <= 0 {
+		config.SegmentSize = defaultSegmentSize
+	}
+	s := &FileLogStore{
+		config:            config,
+		idempotencyWindow: idempotencyWindow,
+		restoreWindow:     restoreWindow,
+		quota:             quota,
+		collections:       make(map[string]*fileCollection),
+		tombstones:        make(map[string]int64),
+		tenantUsage:       make(map[string]usage),
+	}
+	if err := s.open(); err != nil {
+		s.closeFiles()
+		return nil, err
+	}
+	if config.FsyncPolicy == FsyncInterval {
+		s.stop = make(chan struct{})
+		s.wg.Add(1)
+		go s.syncLoop()
+	}
+	return s, nil
+}
+
+func (s *FileLogStore) open() error {
+	tombstoneDir := filepath.Join(s.config.Dir, tombstoneDirName)
+	if err := os.MkdirAll(tombstoneDir, 0o755); err != nil {
+		return err
+	}
+	tombstones, err := os.ReadDir(tombstoneDir)
+	if err != nil {
+		return err
+	}
+	for _, tombstone := range tombstones {
+		if strings.HasSuffix(tombstone.Name(), ".tmp") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(tombstoneDir, tombstone.Name()))
+		if err != nil {
+			return err
+		}
+		deletedAt, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tombstone %s: %w", tombstone.Name(), err)
+		}
+		s.tombstones[tombstone.Name()] = deletedAt
+	}
+	entries, err := os.ReadDir(s.config.Dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == tombstoneDirName {
+			continue
+		}
+		collectionId := entry.Name()
+		dir := filepath.Join(s.config.Dir, collectionId)
+		if _, deleted := s.tombstones[collectionId]; deleted {
+			// the deletion stopped before removing the directory
+			if err := os.RemoveAll(dir); err != nil {
+				return err
+			}
+			continue
+		}
+		collection, err := s.openCollection(collectionId, dir)
+		if err != nil {
+			return fmt.Errorf("failed to open the log of collection %s: %w", collectionId, err)
+		}
+		s.collections[collectionId] = collection
+		s.addTenantUsage(collection.TenantID, collection.RecordEnumerationOffsetPosition-collection.RecordCompactionOffsetPosition, collection.UncompactedBytes)
+	}
+	return nil
+}
+
+func (s *FileLogStore) openCollection(collectionId string, dir string) (*fileCollection, error) {
+	collection := &fileCollection{
+		Collection:      log.Collection{ID: collectionId},
+		dir:             dir,
+		startOffset:     1,
+		idempotencyKeys: make(map[string]log.PushIdempotencyKey),
+	}
+	data, err := os.ReadFile(filepath.Join(dir, metaFileName))
+	if err == nil {
+		var meta fileCollectionMeta
+		if err := json.Unmarshal(data, &meta); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", metaFileName, err)
+		}
+		collection.setMeta(meta)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var firstOffsets []int64
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), segmentFileExt)
+		if !ok {
+			continue
+		}
+		firstOffset, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid segment name %s", entry.Name())
+		}
+		firstOffsets = append(firstOffsets, firstOffset)
+	}
+	sort.Slice(firstOffsets, func(i, j int) bool {
+		return firstOffsets[i] < firstOffsets[j]
+	})
+	collection.RecordEnumerationOffsetPosition = collection.startOffset - 1
+	for i, firstOffset := range firstOffsets {
+		if i > 0 && firstOffset != collection.RecordEnumerationOffsetPosition+1 &&
+			!(firstOffset > collection.RecordEnumerationOffsetPosition && slices.Contains(collection.gapSegments, firstOffset)) {
+			return nil, fmt.Errorf("segment %d does not follow offset %d", firstOffset, collection.RecordEnumerationOffsetPosition)
+		}
+		seg, err := s.recoverSegment(collection, firstOffset, i == len(firstOffsets)-1)
+		if err != nil {
+			return nil, err
+		}
+		collection.segments = append(collection.segments, seg)
+		collection.RecordEnumerationOffsetPosition = seg.lastOffset
+	}
+	// the records are flushed before the meta, but without fsync the meta may still
+	// be on disk when the records it follows are lost. Their offsets are not given
+	// out again, the next records start a new segment after them.
+	if lastOffset := max(collection.RecordCompactionOffsetPosition, collection.startOffset-1); lastOffset > collection.RecordEnumerationOffsetPosition {
+		collection.RecordEnumerationOffsetPosition = lastOffset
+		collection.gapSegments = append(collection.gapSegments, lastOffset+1)
+		if err := s.writeMeta(collection); err != nil {
+			return nil, err
+		}
+		if _, err := s.newSegment(collection); err != nil {
+			return nil, err
+		}
+	}
+	if collection.UncompactedBytes, err = collection.uncompactedBytes(collection.RecordCompactionOffsetPosition); err != nil {
+		return nil, err
+	}
+	// the purge may have stopped before deleting the segments
+	if err := s.dropPurgedSegments(collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+// recoverSegment reads a segment and builds its index. The last segment is cut
+// after its last complete push.
+func (s *FileLogStore) recoverSegment(collection *fileCollection, firstOffset int64, last bool) (*segment, error) {
+	path := filepath.Join(collection.dir, segmentFileName(firstOffset))
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	seg := &segment{
+		firstOffset: firstOffset,
+		lastOffset:  firstOffset - 1,
+		path:        path,
+		file:        file,
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	type pendingFrame struct {
+		frame
+		position int64
+	}
+	// the frames of the push being read, they are indexed once the push is complete
+	var pending []pendingFrame
+	var remaining int32
+	// afterCorrupt is set after a corrupt frame, the length of its push is lost
+	var afterCorrupt bool
+	var position int64
+	var readErr error
+	for {
+		f, err := readFrame(file, position, info.Size())
+		if err == io.EOF {
+			break
+		}
+		expectedOffset := seg.lastOffset + 1 + int64(len(pending))
+		if errors.Is(err, errCorruptFrame) && f.size > 0 && (!last || position+f.size < info.Size()) {
+			// a torn write only cuts the end of the last segment, this is a record
+			// damaged on disk. It keeps its offset and fails its checksum when read.
+			f = frame{offset: expectedOffset, size: f.size, corrupt: true}
+			afterCorrupt = true
+			remaining = max(remaining, 1)
+		} else if err != nil {
+			readErr = err
+			break
+		} else if f.offset != expectedOffset || (remaining > 0 && f.pushLength > 0) {
+			readErr = errCorruptFrame
+			break
+		} else if f.pushLength > 0 {
+			remaining = f.pushLength
+			afterCorrupt = false
+		} else if remaining == 0 {
+			if !afterCorrupt {
+				readErr = errCorruptFrame
+				break
+			}
+			// the rest of the push of a corrupt frame
+			remaining = 1
+		}
+		pending = append(pending, pendingFrame{frame: f, position: position})
+		position += f.size
+		remaining--
+		if remaining == 0 {
+			for _, p := range pending {
+				collection.appendIndex(seg, p.frame, p.position)
+			}
+			pending = pending[:0]
+			seg.size = position
+		}
+	}
+	if readErr == nil && remaining > 0 {
+		readErr = io.ErrUnexpectedEOF
+	}
+	if readErr != nil {
+		if !last {
+			file.Close()
+			return nil, fmt.Errorf("segment %s is corrupt at %d: %w", path, seg.size, readErr)
+		}
+		// a crash in the middle of a push leaves it incomplete
+		if err := file.Truncate(seg.size); err != nil {
+			file.Close()
+			return nil, err
+		}
+		if err := s.sync(file); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return seg, nil
+}
+
+func segmentFileName(firstOffset int64) string {
+	return fmt.Sprintf("%020d%s", firstOffset, segmentFileExt)
+}
+
+// sync flushes a file unless the policy leaves it to the operating system.
+func (s *FileLogStore) sync(file *os.File) error {
+	if s.config.FsyncPolicy == FsyncNever {
+		return nil
+	}
+	return file.Sync()
+}
+
+// syncDir flushes the entries of a directory after a file is created, renamed or
+// removed in it.
+func (s *FileLogStore) syncDir(dir string) error {
+	if s.config.FsyncPolicy == FsyncNever {
+		return nil
+	}
+	file, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return file.Sync()
+}
+
+// writeFile replaces a file through a rename, so that it is either the old or
+// the new content after a crash.
+func (s *FileLogStore) writeFile(path string, data []byte) error {
+	tmpPath := path + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if err == nil {
+		err = s.sync(file)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	return s.syncDir(filepath.Dir(path))
+}
+
+// writeMeta saves the state of a collection, after flushing its records so that
+// the meta does not refer to records lost in a crash. The caller holds the write
+// lock of the collection.
+func (s *FileLogStore) writeMeta(collection *fileCollection) error {
+	for _, seg := range collection.segments {
+		if seg.dirty.Swap(false) {
+			if err := s.sync(seg.file); err != nil {
+				seg.dirty.Store(true)
+				return err
+			}
+		}
+	}
+	data, err := json.Marshal(collection.meta())
+	if err != nil {
+		return err
+	}
+	return s.writeFile(filepath.Join(collection.dir, metaFileName), data)
+}
+
+func (s *FileLogStore) addTenantUsage(tenant pgtype.Text, records int64, bytes int64) {
+	if !tenant.Valid {
+		return
+	}
+	s.usageMu.Lock()
+	defer s.usageMu.Unlock()
+	tenantUsage := s.tenantUsage[tenant.String]
+	tenantUsage.records += records
+	tenantUsage.bytes += bytes
+	if tenantUsage == (usage{}) {
+		delete(s.tenantUsage, tenant.String)
+	} else {
+		s.tenantUsage[tenant.String] = tenantUsage
+	}
+}
+
+func (s *FileLogStore) getCollection(collectionId string) *fileCollection {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.collections[collectionId]
+}
+
+// getOrCreateCollection returns the collection, creating it unless it was deleted.
+func (s *FileLogStore) getOrCreateCollection(collectionId string) (*fileCollection, error) {
+	if collection := s.getCollection(collectionId); collection != nil {
+		return collection, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if collection, ok := s.collections[collectionId]; ok {
+		return collection, nil
+	}
+	if _, deleted := s.tombstones[collectionId]; deleted {
+		return nil, ErrCollectionDeleted
+	}
+	dir := filepath.Join(s.config.Dir, collectionId)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := s.syncDir(s.config.Dir); err != nil {
+		return nil, err
+	}
+	collection := &fileCollection{
+		Collection:      log.Collection{ID: collectionId},
+		dir:             dir,
+		startOffset:     1,
+		idempotencyKeys: make(map[string]log.PushIdempotencyKey),
+	}
+	if err := s.writeMeta(collection); err != nil {
+		return nil, err
+	}
+	s.collections[collectionId] = collection
+	return collection, nil
+}
+
+// lockCollection returns the collection locked for writing, or nil when it does
+// not exist.
+func (s *FileLogStore) lockCollection(collectionId string) *fileCollection {
+	collection := s.getCollection(collectionId)
+	if collection == nil {
+		return nil
+	}
+	collection.mu.Lock()
+	if collection.deleted {
+		collection.mu.Unlock()
+		return nil
+	}
+	return collection
+}
+
+// snapshot returns the open collections.
+func (s *FileLogStore) snapshot() []*fileCollection {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	collections := make([]*fileCollection, 0, len(s.collections))
+	for _, collection := range s.collections {
+		collections = append(collections, collection)
+	}
+	return collections
+}
+
+// activeSegment returns the segment to append to, starting a new one when the
+// last one is full.
+func (s *FileLogStore) activeSegment(collection *fileCollection) (*segment, error) {
+	if n := len(collection.segments); n > 0 {
+		seg := collection.segments[n-1]
+		if seg.size < s.config.SegmentSize {
+			return seg, nil
+		}
+		if seg.dirty.Swap(false) {
+			if err := s.sync(seg.file); err != nil {
+				seg.dirty.Store(true)
+				return nil, err
+			}
+		}
+	}
+	return s.newSegment(collection)
+}
+
+// newSegment starts a segment after the last record of a collection.
+func (s *FileLogStore) newSegment(collection *fileCollection) (*segment, error) {
+	firstOffset := collection.RecordEnumerationOffsetPosition + 1
+	path := filepath.Join(collection.dir, segmentFileName(firstOffset))
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.syncDir(collection.dir); err != nil {
+		file.Close()
+		os.Remove(path)
+		return nil, err
+	}
+	seg := &segment{
+		firstOffset: firstOffset,
+		lastOffset:  firstOffset - 1,
+		path:        path,
+		file:        file,
+	}
+	collection.segments = append(collection.segments, seg)
+	return seg, nil
+}
+
+func (s *FileLogStore) InsertRecords(ctx context.Context, collectionId string, tenantId string, records [][]byte, idempotencyKey string) (InsertedRecords, error) {
+	if len(idempotencyKey) > math.MaxUint16 {
+		return InsertedRecords{}, fmt.Errorf("idempotency key of %d bytes is too long", len(idempotencyKey))
+	}
+	if len(records) > math.MaxInt32 {
+		return InsertedRecords{}, fmt.Errorf("push of %d records is too large", len(records))
+	}
+	collection, err := s.getOrCreateCollection(collectionId)
+	if err != nil {
+		return InsertedRecords{}, err
+	}
+	collection.mu.Lock()
+	defer collection.mu.Unlock()
+	if collection.deleted {
+		return InsertedRecords{}, ErrCollectionDeleted
+	}
+	tenant := pgtype.Text{String: tenantId, Valid: tenantId != ""}
+	if tenant.Valid && tenant != collection.TenantID {
+		previous := collection.TenantID
+		collection.TenantID = tenant
+		if err := s.writeMeta(collection); err != nil {
+			collection.TenantID = previous
+			return InsertedRecords{}, err
+		}
+		pendingRecords := collection.RecordEnumerationOffsetPosition - collection.RecordCompactionOffsetPosition
+		s.addTenantUsage(previous, -pendingRecords, -collection.UncompactedBytes)
+		s.addTenantUsage(tenant, pendingRecords, collection.UncompactedBytes)
+	}
+	now := time.Now().UnixNano()
+	if idempotencyKey != "" {
+		windowStart := now - s.idempotencyWindow.Nanoseconds()
+		expireIdempotencyKeys(collection.idempotencyKeys, windowStart, -1)
+		if pushed, ok := collection.idempotencyKeys[idempotencyKey]; ok {
+			return InsertedRecords{
+				Count:       pushed.RecordCount,
+				FirstOffset: pushed.FirstOffset,
+				LastOffset:  pushed.FirstOffset + pushed.RecordCount - 1,
+				Timestamp:   pushed.Timestamp,
+			}, nil
+		}
+	}
+	var insertBytes int64
+	for _, record := range records {
+		insertBytes += int64(len(record))
+	}
+	insertCount := int64(len(records))
+	if err := s.checkQuota(collection, tenant, insertCount, insertBytes); err != nil {
+		return InsertedRecords{}, err
+	}
+	firstOffset := collection.RecordEnumerationOffsetPosition + 1
+	if insertCount == 0 {
+		return InsertedRecords{FirstOffset: firstOffset, LastOffset: firstOffset - 1, Timestamp: now}, nil
+	}
+	seg, err := s.activeSegment(collection)
+	if err != nil {
+		s.addTenantUsage(tenant, -insertCount, -insertBytes)
+		return InsertedRecords{}, err
+	}
+	frames := make([]frame, len(records))
+	positions := make([]int64, len(records))
+	var buf []byte
+	for i, record := range records {
+		frames[i] = frame{
+			offset:    firstOffset + int64(i),
+			timestamp: now,
+			record:    record,
+		}
+		if i == 0 {
+			frames[i].pushLength = int32(len(records))
+			frames[i].idempotencyKey = idempotencyKey
+		}
+		positions[i] = seg.size + int64(len(buf))
+		buf = appendFrame(buf, frames[i])
+	}
+	_, err = seg.file.WriteAt(buf, seg.size)
+	if err == nil && s.config.FsyncPolicy == FsyncAlways {
+		err = seg.file.Sync()
+	}
+	if err != nil {
+		// the push is dropped again when the store opens if this fails
+		seg.file.Truncate(seg.size)
+		s.addTenantUsage(tenant, -insertCount, -insertBytes)
+		return InsertedRecords{}, err
+	}
+	if s.config.FsyncPolicy == FsyncInterval {
+		seg.dirty.Store(true)
+	}
+	for i, f := range frames {
+		collection.appendIndex(seg, f, positions[i])
+	}
+	seg.size += int64(len(buf))
+	collection.RecordEnumerationOffsetPosition += insertCount
+	collection.UncompactedBytes += insertBytes
+	if !tenant.Valid {
+		// the usage of a push with a tenant is added by checkQuota
+		s.addTenantUsage(collection.TenantID, insertCount, insertBytes)
+	}
+	return InsertedRecords{
+		Count:       insertCount,
+		FirstOffset: firstOffset,
+		LastOffset:  firstOffset + insertCount - 1,
+		Timestamp:   now,
+	}, nil
+}
+
+// checkQuota is LogRepository.checkQuota. The usage of the push is added to the
+// tenant with the check, so that concurrent pushes to the collections of the tenant
+// check its quota one at a time, the caller removes it if the push fails. The caller
+// holds the write lock of the collection.
+func (s *FileLogStore) checkQuota(collection *fileCollection, tenant pgtype.Text, records int64, bytes int64) error {
+	collectionRecords := collection.RecordEnumerationOffsetPosition - collection.RecordCompactionOffsetPosition
+	if err := exceedsQuota("collection", collection.ID, "records", s.quota.MaxCollectionRecords, collectionRecords, records); err != nil {
+		return err
+	}
+	if err := exceedsQuota("collection", collection.ID, "bytes", s.quota.MaxCollectionBytes, collection.UncompactedBytes, bytes); err != nil {
+		return err
+	}
+	if !tenant.Valid {
+		return nil
+	}
+	s.usageMu.Lock()
+	defer s.usageMu.Unlock()
+	tenantUsage := s.tenantUsage[tenant.String]
+	if err := exceedsQuota("tenant", tenant.String, "records", s.quota.MaxTenantRecords, tenantUsage.records, records); err != nil {
+		return err
+	}
+	if err := exceedsQuota("tenant", tenant.String, "bytes", s.quota.MaxTenantBytes, tenantUsage.bytes, bytes); err != nil {
+		return err
+	}
+	if records > 0 {
+		tenantUsage.records += records
+		tenantUsage.bytes += bytes
+		s.tenantUsage[tenant.String] = tenantUsage
+	}
+	return nil
+}
+
+func (s *FileLogStore) PullRecords(ctx context.Context, collectionId string, offset int64, batchSize int, timestamp int64) ([]log.RecordLog, error) {
+	collection := s.getCollection(collectionId)
+	if collection == nil {
+		return nil, nil
+	}
+	collection.mu.RLock()
+	defer collection.mu.RUnlock()
+	if collection.deleted {
+		return nil, nil
+	}
+	var records []log.RecordLog
+	if batchSize < 1 {
+		return nil, nil
+	}
+	// stops at the last record of the batch, so that a corrupt record after it
+	// does not fail the pull. A corrupt record in the batch ends it, it fails the
+	// pull only when it is the first one, like verifyRecords.
+	err := collection.scan(max(offset, collection.startOffset), func(f frame) bool {
+		if f.timestamp <= timestamp {
+			records = append(records, log.RecordLog{
+				Offset:       f.offset,
+				CollectionID: collectionId,
+				Timestamp:    f.timestamp,
+				Record:       f.record,
+			})
+		}
+		return len(records) < batchSize
+	})
+	var checksumErr *ChecksumError
+	if errors.As(err, &checksumErr) && len(records) > 0 {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (s *FileLogStore) GetAllCollectionInfoToCompact(ctx context.Context, filter CompactionFilter) ([]log.GetCollectionsToCompactRow, error) {
+	minPendingRecords := filter.MinPendingRecords
+	if minPendingRecords < 1 {
+		minPendingRecords = 1
+	}
+	maxFirstTimestamp := time.Now().Add(-filter.MinAge).UnixNano()
+	collectionToCompact := []log.GetCollectionsToCompactRow{}
+	for _, collection := range s.snapshot() {
+		row, ok, err := collectionToCompactRow(collection, minPendingRecords, maxFirstTimestamp, filter.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			collectionToCompact = append(collectionToCompact, row)
+		}
+	}
+	sort.Slice(collectionToCompact, func(i, j int) bool {
+		if collectionToCompact[i].FirstTimestamp != collectionToCompact[j].FirstTimestamp {
+			return collectionToCompact[i].FirstTimestamp < collectionToCompact[j].FirstTimestamp
+		}
+		return collectionToCompact[i].CollectionID < collectionToCompact[j].CollectionID
+	})
+	if filter.Limit > 0 && len(collectionToCompact) > int(filter.Limit) {
+		collectionToCompact = collectionToCompact[:filter.Limit]
+	}
+	return collectionToCompact, nil
+}
+
+func collectionToCompactRow(collection *fileCollection, minPendingRecords int64, maxFirstTimestamp int64, tenantId string) (log.GetCollectionsToCompactRow, bool, error) {
+	collection.mu.RLock()
+	defer collection.mu.RUnlock()
+	pendingRecords := collection.RecordEnumerationOffsetPosition - collection.RecordCompactionOffsetPosition
+	if collection.deleted || pendingRecords < minPendingRecords {
+		return log.GetCollectionsToCompactRow{}, false, nil
+	}
+	if tenantId != "" && collection.TenantID.String != tenantId {
+		return log.GetCollectionsToCompactRow{}, false, nil
+	}
+	// the timestamp of a corrupt record is lost, it is 0 so that the collection is
+	// compacted first and the corruption shows up
+	var first frame
+	var found bool
+	err := collection.scanFrames(max(collection.RecordCompactionOffsetPosition+1, collection.startOffset), func(f frame) bool {
+		first, found = f, true
+		return false
+	})
+	if err != nil || !found || first.timestamp > maxFirstTimestamp {
+		return log.GetCollectionsToCompactRow{}, false, err
+	}
+	return log.GetCollectionsToCompactRow{
+		CollectionID:   collection.ID,
+		TenantID:       collection.TenantID,
+		FirstOffset:    first.offset,
+		FirstTimestamp: first.timestamp,
+		LatestOffset:   collection.RecordEnumerationOffsetPosition,
+		PendingRecords: pendingRecords,
+		PendingBytes:   collection.UncompactedBytes,
+	}, true, nil
+}
+
+func (s *FileLogStore) UpdateCollectionCompactionOffsetPosition(ctx context.Context, collectionId string, offsetPosition int64, expectedOffset *int64, allowRegression bool) error {
+	collection := s.lockCollection(collectionId)
+	if collection == nil {
+		// a collection without records has both offsets at 0
+		return checkCompactionOffset(log.Collection{ID: collectionId}, 1, offsetPosition, expectedOffset, allowRegression)
+	}
+	defer collection.mu.Unlock()
+	if err := checkCompactionOffset(collection.Collection, collection.startOffset, offsetPosition, expectedOffset, allowRegression); err != nil {
+		return err
+	}
+	if offsetPosition == collection.RecordCompactionOffsetPosition {
+		return nil
+	}
+	uncompactedBytes, err := collection.uncompactedBytes(offsetPosition)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UnixNano()
+	previousOffset, previousOffsets := collection.RecordCompactionOffsetPosition, collection.compactionOffsets
+	collection.RecordCompactionOffsetPosition = offsetPosition
+	collection.compactionOffsets = addCompactionOffset(slices.Clone(previousOffsets), offsetPosition, now, now-s.restoreWindow.Nanoseconds())
+	if err := s.writeMeta(collection); err != nil {
+		collection.RecordCompactionOffsetPosition = previousOffset
+		collection.compactionOffsets = previousOffsets
+		return err
+	}
+	s.addTenantUsage(collection.TenantID, previousOffset-offsetPosition, uncompactedBytes-collection.UncompactedBytes)
+	collection.UncompactedBytes = uncompactedBytes
+	return nil
+}
+
+// purge moves the start of the log of a collection and deletes the segments
+// before it. The caller holds the write lock of the collection.
+func (s *FileLogStore) purge(collection *fileCollection, startOffset int64) error {
+	previousOffset := collection.startOffset
+	collection.startOffset = startOffset
+	if err := s.writeMeta(collection); err != nil {
+		collection.startOffset = previousOffset
+		return err
+	}
+	return s.dropPurgedSegments(collection)
+}
+
+// dropPurgedSegments deletes the segments before the start of the log, the last
+// segment is kept to append to.
+func (s *FileLogStore) dropPurgedSegments(collection *fileCollection) error {
+	for len(collection.segments) > 1 && collection.segments[0].lastOffset < collection.startOffset {
+		seg := collection.segments[0]
+		if err := os.Remove(seg.path); err != nil {
+			return err
+		}
+		seg.file.Close()
+		collection.segments = collection.segments[1:]
+	}
+	return nil
+}
+
+func (s *FileLogStore) PurgeRecords(ctx context.Context) error {
+	windowStart := time.Now().UnixNano() - s.restoreWindow.Nanoseconds()
+	for _, collection := range s.snapshot() {
+		collection.mu.Lock()
+		var err error
+		startOffset := lastPurgeableOffset(collection.RecordCompactionOffsetPosition, collection.compactionOffsets, windowStart) + 1
+		if !collection.deleted && startOffset > collection.startOffset {
+			err = s.purge(collection, startOffset)
+		}
+		collection.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *FileLogStore) PurgeRecordsBatch(ctx context.Context, batchSize int32, defaultRetention Retention) (int64, error) {
+	now := time.Now().UnixNano()
+	var purgeCount int64
+	for _, collection := range s.snapshot() {
+		if purgeCount >= int64(batchSize) {
+			break
+		}
+		count, err := s.purgeBatch(collection, int64(batchSize)-purgeCount, defaultRetention, now)
+		purgeCount += count
+		if err != nil {
+			return purgeCount, err
+		}
+	}
+	return purgeCount, nil
+}
+
+// PurgeIdempotencyKeysBatch is LogRepository.PurgeIdempotencyKeysBatch. The keys
+// are only dropped from memory, a key read again from the segments when the store
+// opens is out of the window as well.
+func (s *FileLogStore) PurgeIdempotencyKeysBatch(ctx context.Context, batchSize int32) (int64, error) {
+	windowStart := time.Now().UnixNano() - s.idempotencyWindow.Nanoseconds()
+	var purgeCount int64
+	for _, collection := range s.snapshot() {
+		if purgeCount >= int64(batchSize) {
+			break
+		}
+		collection.mu.Lock()
+		purgeCount += expireIdempotencyKeys(collection.idempotencyKeys, windowStart, int64(batchSize)-purgeCount)
+		collection.mu.Unlock()
+	}
+	return purgeCount, nil
+}
+
+// purgeBatch purges up to limit records of a collection, see PurgeRecordsBatch.
+func (s *FileLogStore) purgeBatch(collection *fileCollection, limit int64, defaultRetention Retention, now int64) (int64, error) {
+	collection.mu.Lock()
+	defer collection.mu.Unlock()
+	if collection.deleted {
+		return 0, nil
+	}
+	periodSeconds := defaultRetention.PeriodSeconds
+	if collection.RetentionPeriodSeconds.Valid {
+		periodSeconds = collection.RetentionPeriodSeconds.Int64
+	}
+	recordCount := defaultRetention.RecordCount
+	if collection.RetentionRecordCount.Valid {
+		recordCount = collection.RetentionRecordCount.Int64
+	}
+	lastOffset := lastPurgeableOffset(collection.RecordCompactionOffsetPosition, collection.compactionOffsets, now-s.restoreWindow.Nanoseconds())
+	var count int64
+	startOffset := collection.startOffset
+	// a corrupt record has a timestamp of 0, it is purged with the records around it
+	err := collection.scanFrames(startOffset, func(f frame) bool {
+		if count >= limit ||
+			f.offset > lastOffset ||
+			f.timestamp > now-periodSeconds*int64(time.Second) ||
+			f.offset > collection.RecordEnumerationOffsetPosition-recordCount {
+			return false
+		}
+		count++
+		startOffset = f.offset + 1
+		return true
+	})
+	if err != nil || count == 0 {
+		return 0, err
+	}
+	if err := s.purge(collection, startOffset); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *FileLogStore) SetCollectionRetention(ctx context.Context, collectionId string, periodSeconds *int64, recordCount *int64) error {
+	collection, err := s.getOrCreateCollection(collectionId)
+	if err != nil {
+		return err
+	}
+	collection.mu.Lock()
+	defer collection.mu.Unlock()
+	if collection.deleted {
+		return ErrCollectionDeleted
+	}
+	meta := collection.meta()
+	meta.RetentionPeriodSeconds = periodSeconds
+	meta.RetentionRecordCount = recordCount
+	previous := collection.meta()
+	collection.setMeta(meta)
+	if err := s.writeMeta(collection); err != nil {
+		collection.setMeta(previous)
+		return err
+	}
+	return nil
+}
+
+func (s *FileLogStore) DeleteCollection(ctx context.Context, collectionId string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, deleted := s.tombstones[collectionId]; !deleted {
+		deletedAt := time.Now().UnixNano()
+		tombstonePath := filepath.Join(s.config.Dir, tombstoneDirName, collectionId)
+		if err := s.writeFile(tombstonePath, []byte(strconv.FormatInt(deletedAt, 10))); err != nil {
+			return 0, err
+		}
+		s.tombstones[collectionId] = deletedAt
+	}
+	collection, ok := s.collections[collectionId]
+	if !ok {
+		return 0, nil
+	}
+	collection.mu.Lock()
+	defer collection.mu.Unlock()
+	collection.deleted = true
+	delete(s.collections, collectionId)
+	s.addTenantUsage(collection.TenantID, collection.RecordCompactionOffsetPosition-collection.RecordEnumerationOffsetPosition, -collection.UncompactedBytes)
+	for _, seg := range collection.segments {
+		seg.file.Close()
+	}
+	// the directory of a tombstoned collection is removed again when the store
+	// opens if this fails
+	if err := os.RemoveAll(collection.dir); err != nil {
+		return 0, err
+	}
+	return max(collection.RecordEnumerationOffsetPosition-collection.startOffset+1, 0), nil
+}
+
+func (s *FileLogStore) VerifyCollectionLog(ctx context.Context, collectionId string) (LogVerification, error) {
+	collection := s.getCollection(collectionId)
+	if collection == nil {
+		return newLogVerifier(0, 0).finish(), nil
+	}
+	collection.mu.RLock()
+	defer collection.mu.RUnlock()
+	if collection.deleted {
+		return newLogVerifier(0, 0).finish(), nil
+	}
+	verifier := newLogVerifier(collection.RecordCompactionOffsetPosition, collection.RecordEnumerationOffsetPosition)
+	for _, seg := range collection.segments {
+		// a frame that can not be decoded hides the rest of its segment, the
+		// other segments are still checked
+		next := seg.firstOffset
+		for position := int64(0); position < seg.size; {
+			f, err := readFrame(seg.file, position, seg.size)
+			if errors.Is(err, errCorruptFrame) && f.size > 0 {
+				f = frame{offset: next, size: f.size, corrupt: true}
+			} else if err != nil {
+				verifier.report(LogIssueChecksumMismatch, next, fmt.Sprintf("%s can not be read from %d on: %v", seg.path, position, err))
+				break
+			}
+			position += f.size
+			next = f.offset + 1
+			if f.offset < collection.startOffset {
+				continue
+			}
+			verifier.add(f.offset)
+			if f.corrupt {
+				verifier.report(LogIssueChecksumMismatch, f.offset, "the record does not match its checksum")
+			}
+		}
+	}
+	return verifier.finish(), nil
+}
+
+func (s *FileLogStore) syncLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.config.FsyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			// a failed flush is retried on the next tick and reported by Close
+			_ = s.syncSegments()
+		}
+	}
+}
+
+// syncSegments flushes the segments with records that are not flushed yet.
+func (s *FileLogStore) syncSegments() error {
+	var errs []error
+	for _, collection := range s.snapshot() {
+		collection.mu.RLock()
+		for _, seg := range collection.segments {
+			if !seg.dirty.Swap(false) {
+				continue
+			}
+			if err := seg.file.Sync(); err != nil {
+				seg.dirty.Store(true)
+				errs = append(errs, err)
+			}
+		}
+		collection.mu.RUnlock()
+	}
+	return errors.Join(errs...)
+}
+
+// closeFiles closes the segments of every collection.
+func (s *FileLogStore) closeFiles() error {
+	var errs []error
+	for _, collection := range s.snapshot() {
+		collection.mu.Lock()
+		for _, seg := range collection.segments {
+			if err := seg.file.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		collection.segments = nil
+		collection.deleted = true
+		collection.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// Close flushes the pending records and closes the files. The store can not be
+// used afterwards.
+func (s *FileLogStore) Close() error {
+	if s.stop != nil {
+		close(s.stop)
+		s.wg.Wait()
+	}
+	syncErr := s.syncSegments()
+	return errors.Join(syncErr, s.closeFiles())
+}
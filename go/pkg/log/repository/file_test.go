@@ -0,0 +1,345 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func openFileLogStore(t *testing.T, dir string) *FileLogStore {
+	store, err := NewFileLogStore(FileLogStoreConfig{
+		Dir:         dir,
+		FsyncPolicy: FsyncAlways,
+		SegmentSize: 256,
+	}, time.Minute, time.Hour, Quota{})
+	if err != nil {
+		t.Fatalf("Failed to open the file log store: %v", err)
+	}
+	return store
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+segmentFileExt))
+	assert.NoError(t, err)
+	return files
+}
+
+func TestFileLogStore_Reopen(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := openFileLogStore(t, dir)
+	for i := 0; i < 20; i++ {
+		_, err := store.InsertRecords(ctx, "c1", "t1", [][]byte{[]byte("record-a"), []byte("record-b")}, "")
+		assert.NoError(t, err)
+	}
+	pushed, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("record-c")}, "key")
+	assert.NoError(t, err)
+	assert.NoError(t, store.UpdateCollectionCompactionOffsetPosition(ctx, "c1", 10, nil, false))
+	period := int64(60)
+	assert.NoError(t, store.SetCollectionRetention(ctx, "c1", &period, nil))
+	assert.NoError(t, store.Close())
+	assert.Greater(t, len(segmentFiles(t, filepath.Join(dir, "c1"))), 1)
+
+	store = openFileLogStore(t, dir)
+	defer store.Close()
+	records, err := store.PullRecords(ctx, "c1", 1, 100, time.Now().UnixNano())
+	assert.NoError(t, err)
+	if !assert.Len(t, records, 41) {
+		return
+	}
+	for i, record := range records {
+		assert.Equal(t, int64(i+1), record.Offset)
+	}
+	assert.Equal(t, []byte("record-c"), records[40].Record)
+	// the idempotency key survives the restart
+	retried, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("record-c")}, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, pushed, retried)
+	collections, err := store.GetAllCollectionInfoToCompact(ctx, CompactionFilter{TenantID: "t1"})
+	assert.NoError(t, err)
+	if !assert.Len(t, collections, 1) {
+		return
+	}
+	assert.Equal(t, int64(11), collections[0].FirstOffset)
+	assert.Equal(t, int64(31), collections[0].PendingRecords)
+	assert.Equal(t, int64(31*8), collections[0].PendingBytes)
+	assert.Equal(t, period, store.collections["c1"].RetentionPeriodSeconds.Int64)
+}
+
+func TestFileLogStore_TornPush(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := openFileLogStore(t, dir)
+	_, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("record")}, "")
+	assert.NoError(t, err)
+	assert.NoError(t, store.Close())
+
+	// a crash wrote the first record of a push of two
+	segmentPath := filepath.Join(dir, "c1", segmentFileName(1))
+	file, err := os.OpenFile(segmentPath, os.O_WRONLY|os.O_APPEND, 0)
+	assert.NoError(t, err)
+	_, err = file.Write(appendFrame(nil, frame{offset: 2, timestamp: time.Now().UnixNano(), pushLength: 2, record: []byte("torn")}))
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	store = openFileLogStore(t, dir)
+	defer store.Close()
+	inserted, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("next")}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), inserted.FirstOffset)
+	records, err := store.PullRecords(ctx, "c1", 1, 10, time.Now().UnixNano())
+	assert.NoError(t, err)
+	if !assert.Len(t, records, 2) {
+		return
+	}
+	assert.Equal(t, []byte("next"), records[1].Record)
+}
+
+func TestFileLogStore_LostTail(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	config := FileLogStoreConfig{Dir: dir, FsyncPolicy: FsyncNever, SegmentSize: 1 << 20}
+	store, err := NewFileLogStore(config, time.Minute, time.Hour, Quota{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	for i := 0; i < 5; i++ {
+		_, err = store.InsertRecords(ctx, "c1", "t1", [][]byte{[]byte("record")}, "")
+		assert.NoError(t, err)
+	}
+	segmentPath := filepath.Join(dir, "c1", segmentFileName(1))
+	info, err := os.Stat(segmentPath)
+	assert.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err = store.InsertRecords(ctx, "c1", "t1", [][]byte{[]byte("record")}, "")
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, store.UpdateCollectionCompactionOffsetPosition(ctx, "c1", 8, nil, false))
+	assert.NoError(t, store.Close())
+	// a crash kept the meta but lost the records after the fifth one
+	assert.NoError(t, os.Truncate(segmentPath, info.Size()))
+
+	for reopen := 0; reopen < 2; reopen++ {
+		store, err = NewFileLogStore(config, time.Minute, time.Hour, Quota{})
+		if !assert.NoError(t, err) {
+			return
+		}
+		// the offsets of the lost records are not given out again
+		inserted, err := store.InsertRecords(ctx, "c1", "t1", [][]byte{[]byte("next")}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9+reopen), inserted.FirstOffset)
+		collections, err := store.GetAllCollectionInfoToCompact(ctx, CompactionFilter{TenantID: "t1"})
+		assert.NoError(t, err)
+		if assert.Len(t, collections, 1) {
+			assert.Equal(t, int64(9), collections[0].FirstOffset)
+			assert.Equal(t, int64(1+reopen), collections[0].PendingRecords)
+		}
+		records, err := store.PullRecords(ctx, "c1", 1, 100, time.Now().UnixNano())
+		assert.NoError(t, err)
+		assert.Len(t, records, 6+reopen)
+		assert.NoError(t, store.Close())
+	}
+}
+
+func TestFileLogStore_CorruptRecord(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := openFileLogStore(t, dir)
+	for i := 0; i < 20; i++ {
+		_, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("record")}, "")
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, store.Close())
+
+	// the segments have 8 records, flip a bit of the last record of the first one
+	// and of the second record of the last one
+	for _, corruption := range []struct {
+		firstOffset int64
+		position    int
+	}{{1, 8*36 - 1}, {17, 2*36 - 1}} {
+		segmentPath := filepath.Join(dir, "c1", segmentFileName(corruption.firstOffset))
+		data, err := os.ReadFile(segmentPath)
+		assert.NoError(t, err)
+		data[corruption.position] ^= 0xff
+		assert.NoError(t, os.WriteFile(segmentPath, data, 0o644))
+	}
+
+	store = openFileLogStore(t, dir)
+	defer store.Close()
+	records, err := store.PullRecords(ctx, "c1", 1, 7, time.Now().UnixNano())
+	assert.NoError(t, err)
+	assert.Len(t, records, 7)
+	// the batch ends before the corrupt record, which fails the next pull
+	records, err = store.PullRecords(ctx, "c1", 1, 10, time.Now().UnixNano())
+	assert.NoError(t, err)
+	assert.Len(t, records, 7)
+	_, err = store.PullRecords(ctx, "c1", 8, 10, time.Now().UnixNano())
+	var checksumErr *ChecksumError
+	if assert.ErrorAs(t, err, &checksumErr) {
+		assert.Equal(t, int64(8), checksumErr.Offset)
+	}
+	verification, err := store.VerifyCollectionLog(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), verification.RecordCount)
+	assert.Equal(t, []LogIssue{
+		{Kind: LogIssueChecksumMismatch, Offset: 8, Description: "the record does not match its checksum"},
+		{Kind: LogIssueChecksumMismatch, Offset: 18, Description: "the record does not match its checksum"},
+	}, verification.Issues)
+	// the record in the middle of the last segment is not taken for a torn write
+	inserted, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("record")}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(21), inserted.FirstOffset)
+}
+
+func TestFileLogStore_CorruptSegment(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := openFileLogStore(t, dir)
+	for i := 0; i < 20; i++ {
+		_, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("record")}, "")
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, store.Close())
+
+	// without the length of a frame the rest of the segment can not be read, only
+	// the end of the last segment can be cut by a crash
+	segmentPath := filepath.Join(dir, "c1", segmentFileName(1))
+	data, err := os.ReadFile(segmentPath)
+	assert.NoError(t, err)
+	copy(data[36:], []byte{0, 0, 0, 0})
+	assert.NoError(t, os.WriteFile(segmentPath, data, 0o644))
+	_, err = NewFileLogStore(FileLogStoreConfig{Dir: dir}, time.Minute, time.Hour, Quota{})
+	assert.ErrorIs(t, err, errCorruptFrame)
+}
+
+func TestFileLogStore_PurgeDropsSegments(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := openFileLogStore(t, dir)
+	for i := 0; i < 40; i++ {
+		_, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("record")}, "")
+		assert.NoError(t, err)
+	}
+	collectionDir := filepath.Join(dir, "c1")
+	segmentCount := len(segmentFiles(t, collectionDir))
+	assert.NoError(t, store.UpdateCollectionCompactionOffsetPosition(ctx, "c1", 30, nil, false))
+	assert.NoError(t, store.PurgeRecords(ctx))
+	assert.Less(t, len(segmentFiles(t, collectionDir)), segmentCount)
+	assert.NoError(t, store.Close())
+
+	store = openFileLogStore(t, dir)
+	defer store.Close()
+	records, err := store.PullRecords(ctx, "c1", 1, 100, time.Now().UnixNano())
+	assert.NoError(t, err)
+	if !assert.Len(t, records, 11) {
+		return
+	}
+	assert.Equal(t, int64(30), records[0].Offset)
+	inserted, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("record")}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(41), inserted.FirstOffset)
+}
+
+func TestFileLogStore_DeleteCollection(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := openFileLogStore(t, dir)
+	_, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("a"), []byte("b")}, "")
+	assert.NoError(t, err)
+	deleted, err := store.DeleteCollection(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	assert.NoDirExists(t, filepath.Join(dir, "c1"))
+	assert.NoError(t, store.Close())
+
+	store = openFileLogStore(t, dir)
+	defer store.Close()
+	_, err = store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("c")}, "")
+	assert.ErrorIs(t, err, ErrCollectionDeleted)
+}
+
+func TestFileLogStore_PurgeIdempotencyKeysBatch(t *testing.T) {
+	store, err := NewFileLogStore(FileLogStoreConfig{Dir: t.TempDir(), FsyncPolicy: FsyncNever}, 50*time.Millisecond, time.Hour, Quota{})
+	if err != nil {
+		t.Fatalf("Failed to open the file log store: %v", err)
+	}
+	defer store.Close()
+	testPurgeIdempotencyKeysBatch(t, store)
+}
+
+// testPurgeIdempotencyKeysBatch checks the purge of the keys of a store with an
+// idempotency window of 50ms.
+func testPurgeIdempotencyKeysBatch(t *testing.T, store LogStore) {
+	ctx := context.Background()
+	for _, push := range []struct {
+		collectionID   string
+		idempotencyKey string
+	}{{"c1", "k1"}, {"c1", "k2"}, {"c1", "k3"}, {"c2", "k4"}, {"c2", ""}} {
+		_, err := store.InsertRecords(ctx, push.collectionID, "", [][]byte{[]byte("a")}, push.idempotencyKey)
+		assert.NoError(t, err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	recent, err := store.InsertRecords(ctx, "c3", "", [][]byte{[]byte("a")}, "k5")
+	assert.NoError(t, err)
+
+	// the expired keys are purged in batches, the recent one is kept
+	for _, expected := range []int64{3, 1, 0} {
+		count, err := store.PurgeIdempotencyKeysBatch(ctx, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, count)
+	}
+	retried, err := store.InsertRecords(ctx, "c3", "", [][]byte{[]byte("a")}, "k5")
+	assert.NoError(t, err)
+	assert.Equal(t, recent, retried)
+}
+
+func TestFileLogStore_PurgeRestoreWindow(t *testing.T) {
+	store, err := NewFileLogStore(FileLogStoreConfig{Dir: t.TempDir(), FsyncPolicy: FsyncNever}, time.Minute, 50*time.Millisecond, Quota{})
+	if err != nil {
+		t.Fatalf("Failed to open the file log store: %v", err)
+	}
+	defer store.Close()
+	testPurgeRestoreWindow(t, store)
+}
+
+// testPurgeRestoreWindow checks the purge of the records of a store with a restore
+// window of 50ms.
+func testPurgeRestoreWindow(t *testing.T, store LogStore) {
+	ctx := context.Background()
+	records := make([][]byte, 10)
+	for i := range records {
+		records[i] = []byte("a")
+	}
+	_, err := store.InsertRecords(ctx, "c1", "", records, "")
+	assert.NoError(t, err)
+	for _, offset := range []int64{4, 8} {
+		assert.NoError(t, store.UpdateCollectionCompactionOffsetPosition(ctx, "c1", offset, nil, false))
+	}
+	firstOffset := func() int64 {
+		records, err := store.PullRecords(ctx, "c1", 0, 1, time.Now().UnixNano())
+		assert.NoError(t, err)
+		assert.Len(t, records, 1)
+		return records[0].Offset
+	}
+	var compactionOffsetErr *CompactionOffsetError
+
+	// the version at offset 4 is in the window, the records after it are kept
+	assert.NoError(t, store.PurgeRecords(ctx))
+	assert.Equal(t, int64(5), firstOffset())
+	err = store.UpdateCollectionCompactionOffsetPosition(ctx, "c1", 2, nil, true)
+	assert.ErrorAs(t, err, &compactionOffsetErr)
+
+	// once it leaves the window the records up to the compaction offset are purged
+	time.Sleep(100 * time.Millisecond)
+	count, err := store.PurgeRecordsBatch(ctx, 100, Retention{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, int64(8), firstOffset())
+	err = store.UpdateCollectionCompactionOffsetPosition(ctx, "c1", 4, nil, true)
+	assert.ErrorAs(t, err, &compactionOffsetErr)
+	assert.NoError(t, store.UpdateCollectionCompactionOffsetPosition(ctx, "c1", 7, nil, true))
+}
@@ -3,19 +3,109 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	log "github.com/chroma-core/chroma/go/database/log/db"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"math"
 	"time"
 )
 
+// Retention keeps compacted records in the log for a while. The zero value
+// keeps none of them.
+type Retention struct {
+	PeriodSeconds int64
+	RecordCount   int64
+}
+
+// InsertedRecords describes the records appended by a push, they have the offsets
+// from FirstOffset to LastOffset. FirstOffset is after LastOffset when nothing was
+// appended.
+type InsertedRecords struct {
+	Count       int64
+	FirstOffset int64
+	LastOffset  int64
+	// Timestamp is the timestamp of the records in nanoseconds
+	Timestamp int64
+}
+
+// ErrCollectionDeleted is returned when writing to the log of a deleted collection
+var ErrCollectionDeleted = errors.New("collection deleted")
+
+// Quota limits the records and bytes pushed but not compacted yet, per collection
+// and per tenant. A limit of 0 is no limit.
+type Quota struct {
+	MaxCollectionRecords int64
+	MaxCollectionBytes   int64
+	MaxTenantRecords     int64
+	MaxTenantBytes       int64
+}
+
+// QuotaExceededError is returned by InsertRecords when the push would take the
+// uncompacted records or bytes of a collection or a tenant over their limit.
+type QuotaExceededError struct {
+	// Scope is "collection" or "tenant"
+	Scope string
+	// Subject is the id of the collection or of the tenant
+	Subject string
+	// Resource is "records" or "bytes"
+	Resource string
+	Limit    int64
+	Usage    int64
+	// Requested is what the push would have added
+	Requested int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s %s has %d uncompacted %s, pushing %d more exceeds the limit of %d", e.Scope, e.Subject, e.Usage, e.Resource, e.Requested, e.Limit)
+}
+
+// CompactionOffsetError is returned when the compaction offset of a collection may
+// not be moved to the requested offset. It has the offsets of the collection at
+// the time of the update.
+type CompactionOffsetError struct {
+	CollectionID      string
+	Requested         int64
+	Reason            string
+	CompactionOffset  int64
+	EnumerationOffset int64
+}
+
+func (e *CompactionOffsetError) Error() string {
+	return fmt.Sprintf("cannot move the compaction offset of collection %s to %d, %s: compaction offset %d, enumeration offset %d", e.CollectionID, e.Requested, e.Reason, e.CompactionOffset, e.EnumerationOffset)
+}
+
 type LogRepository struct {
-	conn    *pgx.Conn
+	pool    *pgxpool.Pool
 	queries *log.Queries
+	// idempotencyWindow is how long the key of a push is remembered
+	idempotencyWindow time.Duration
+	// restoreWindow is how long the versions made by a compaction stay restorable
+	restoreWindow time.Duration
+	quota         Quota
+}
+
+// InsertRecords appends records to the log of a collection. A push retried with the
+// idempotency key of a push of the last idempotency window inserts nothing and returns
+// the records of the first push. An empty key disables the deduplication.
+// The push is rejected with a QuotaExceededError when it exceeds the quota of the
+// collection or of its tenant, an empty tenant id skips the tenant quota.
+func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string, tenantId string, records [][]byte, idempotencyKey string) (inserted InsertedRecords, err error) {
+	inserted, err = r.insertRecords(ctx, collectionId, tenantId, records, idempotencyKey)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		// A concurrent writer created the collection row first. The retry finds
+		// that row and waits on its lock.
+		inserted, err = r.insertRecords(ctx, collectionId, tenantId, records, idempotencyKey)
+	}
+	return
 }
 
-func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string, records [][]byte) (insertCount int64, err error) {
+func (r *LogRepository) insertRecords(ctx context.Context, collectionId string, tenantId string, records [][]byte, idempotencyKey string) (inserted InsertedRecords, err error) {
 	var tx pgx.Tx
-	tx, err = r.conn.BeginTx(ctx, pgx.TxOptions{})
+	tx, err = r.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return
 	}
@@ -28,14 +118,27 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 			err = tx.Commit(ctx)
 		}
 	}()
+	tenant := pgtype.Text{String: tenantId, Valid: tenantId != ""}
 	collection, err = queriesWithTx.GetCollectionForUpdate(ctx, collectionId)
 	if err != nil {
 		// If no row found, insert one.
 		if errors.Is(err, pgx.ErrNoRows) {
+			// A deleted collection has a tombstone instead of a row. DeleteCollection
+			// holds the lock of the row until the tombstone is committed.
+			var deleted bool
+			deleted, err = queriesWithTx.IsCollectionTombstoned(ctx, collectionId)
+			if err != nil {
+				return
+			}
+			if deleted {
+				err = ErrCollectionDeleted
+				return
+			}
 			collection, err = queriesWithTx.InsertCollection(ctx, log.InsertCollectionParams{
 				ID:                              collectionId,
 				RecordEnumerationOffsetPosition: 0,
 				RecordCompactionOffsetPosition:  0,
+				TenantID:                        tenant,
 			})
 			if err != nil {
 				return
@@ -43,6 +146,54 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 		} else {
 			return
 		}
+	} else if tenant.Valid && tenant != collection.TenantID {
+		err = queriesWithTx.UpdateCollectionTenant(ctx, log.UpdateCollectionTenantParams{
+			ID:       collectionId,
+			TenantID: tenant,
+		})
+		if err != nil {
+			return
+		}
+	}
+	now := time.Now().UnixNano()
+	if idempotencyKey != "" {
+		// The lock on the collection row serializes the pushes, so a concurrent retry
+		// sees the key once the first push commits.
+		windowStart := now - r.idempotencyWindow.Nanoseconds()
+		err = queriesWithTx.DeleteExpiredIdempotencyKeys(ctx, log.DeleteExpiredIdempotencyKeysParams{
+			CollectionID: collectionId,
+			Timestamp:    windowStart,
+		})
+		if err != nil {
+			return
+		}
+		var pushed log.PushIdempotencyKey
+		pushed, err = queriesWithTx.GetIdempotencyKey(ctx, log.GetIdempotencyKeyParams{
+			CollectionID:   collectionId,
+			IdempotencyKey: idempotencyKey,
+			Timestamp:      windowStart,
+		})
+		if err == nil {
+			inserted = InsertedRecords{
+				Count:       pushed.RecordCount,
+				FirstOffset: pushed.FirstOffset,
+				LastOffset:  pushed.FirstOffset + pushed.RecordCount - 1,
+				Timestamp:   pushed.Timestamp,
+			}
+			return
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return
+		}
+		err = nil
+	}
+	var insertBytes int64
+	for _, record := range records {
+		insertBytes += int64(len(record))
+	}
+	err = r.checkQuota(ctx, queriesWithTx, collection, tenant, int64(len(records)), insertBytes)
+	if err != nil {
+		return
 	}
 	params := make([]log.InsertRecordParams, len(records))
 	for i, record := range records {
@@ -51,9 +202,11 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 			CollectionID: collectionId,
 			Record:       record,
 			Offset:       offset,
-			Timestamp:    time.Now().UnixNano(),
+			Timestamp:    now,
+			Checksum:     recordChecksum(record),
 		}
 	}
+	var insertCount int64
 	insertCount, err = queriesWithTx.InsertRecord(ctx, params)
 	if err != nil {
 		return
@@ -61,10 +214,74 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 	err = queriesWithTx.UpdateCollectionEnumerationOffsetPosition(ctx, log.UpdateCollectionEnumerationOffsetPositionParams{
 		ID:                              collectionId,
 		RecordEnumerationOffsetPosition: collection.RecordEnumerationOffsetPosition + insertCount,
+		UncompactedBytes:                insertBytes,
 	})
+	if err != nil {
+		return
+	}
+	if idempotencyKey != "" {
+		err = queriesWithTx.InsertIdempotencyKey(ctx, log.InsertIdempotencyKeyParams{
+			CollectionID:   collectionId,
+			IdempotencyKey: idempotencyKey,
+			FirstOffset:    collection.RecordEnumerationOffsetPosition + 1,
+			RecordCount:    insertCount,
+			Timestamp:      now,
+		})
+		if err != nil {
+			return
+		}
+	}
+	inserted = InsertedRecords{
+		Count:       insertCount,
+		FirstOffset: collection.RecordEnumerationOffsetPosition + 1,
+		LastOffset:  collection.RecordEnumerationOffsetPosition + insertCount,
+		Timestamp:   now,
+	}
 	return
 }
 
+// checkQuota returns a QuotaExceededError when pushing records and bytes more takes
+// the collection or its tenant over the quota. The usage of the tenant is read under
+// a transaction lock of the tenant, so that the pushes to its collections check the
+// tenant quota one at a time. The caller runs it in the transaction of the push.
+func (r *LogRepository) checkQuota(ctx context.Context, queries *log.Queries, collection log.Collection, tenant pgtype.Text, records int64, bytes int64) error {
+	collectionRecords := collection.RecordEnumerationOffsetPosition - collection.RecordCompactionOffsetPosition
+	if err := exceedsQuota("collection", collection.ID, "records", r.quota.MaxCollectionRecords, collectionRecords, records); err != nil {
+		return err
+	}
+	if err := exceedsQuota("collection", collection.ID, "bytes", r.quota.MaxCollectionBytes, collection.UncompactedBytes, bytes); err != nil {
+		return err
+	}
+	if !tenant.Valid || (r.quota.MaxTenantRecords <= 0 && r.quota.MaxTenantBytes <= 0) {
+		return nil
+	}
+	if err := queries.LockTenantUsage(ctx, tenant.String); err != nil {
+		return err
+	}
+	usage, err := queries.GetTenantUncompactedUsage(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	if err := exceedsQuota("tenant", tenant.String, "records", r.quota.MaxTenantRecords, usage.Records, records); err != nil {
+		return err
+	}
+	return exceedsQuota("tenant", tenant.String, "bytes", r.quota.MaxTenantBytes, usage.Bytes, bytes)
+}
+
+func exceedsQuota(scope string, subject string, resource string, limit int64, usage int64, requested int64) error {
+	if limit <= 0 || usage+requested <= limit {
+		return nil
+	}
+	return &QuotaExceededError{
+		Scope:     scope,
+		Subject:   subject,
+		Resource:  resource,
+		Limit:     limit,
+		Usage:     usage,
+		Requested: requested,
+	}
+}
+
 func (r *LogRepository) PullRecords(ctx context.Context, collectionId string, offset int64, batchSize int, timestamp int64) (records []log.RecordLog, err error) {
 	records, err = r.queries.GetRecordsForCollection(ctx, log.GetRecordsForCollectionParams{
 		CollectionID: collectionId,
@@ -72,32 +289,340 @@ func (r *LogRepository) PullRecords(ctx context.Context, collectionId string, of
 		Limit:        int32(batchSize),
 		Timestamp:    timestamp,
 	})
+	if err != nil {
+		return
+	}
+	records, err = verifyRecords(collectionId, records)
+	return
+}
+
+// verifyBatchSize is the number of records read at once by VerifyCollectionLog
+const verifyBatchSize = 1000
+
+// VerifyCollectionLog reads the log of a collection and reports the records that
+// do not match their checksum, the offsets missing after the compaction offset and
+// the records after the enumeration offset. It reads a snapshot of the log.
+func (r *LogRepository) VerifyCollectionLog(ctx context.Context, collectionId string) (verification LogVerification, err error) {
+	var tx pgx.Tx
+	tx, err = r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return
+	}
+	defer tx.Rollback(ctx)
+	queriesWithTx := r.queries.WithTx(tx)
+	var collection log.Collection
+	collection, err = queriesWithTx.GetCollection(ctx, collectionId)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return
+		}
+		// without a row both offsets are 0, so any record is beyond them
+		collection = log.Collection{ID: collectionId}
+		err = nil
+	}
+	verifier := newLogVerifier(collection.RecordCompactionOffsetPosition, collection.RecordEnumerationOffsetPosition)
+	var offset int64
+	for {
+		var records []log.RecordLog
+		records, err = queriesWithTx.GetRecordsForCollection(ctx, log.GetRecordsForCollectionParams{
+			CollectionID: collectionId,
+			Offset:       offset,
+			Limit:        verifyBatchSize,
+			Timestamp:    math.MaxInt64,
+		})
+		if err != nil {
+			return
+		}
+		for _, record := range records {
+			verifier.addRecord(record.Offset, record.Record, record.Checksum)
+		}
+		if len(records) < verifyBatchSize {
+			break
+		}
+		offset = records[len(records)-1].Offset + 1
+	}
+	verification = verifier.finish()
 	return
 }
 
-func (r *LogRepository) GetAllCollectionInfoToCompact(ctx context.Context) (collectionToCompact []log.GetAllCollectionsToCompactRow, err error) {
-	collectionToCompact, err = r.queries.GetAllCollectionsToCompact(ctx)
+// CompactionFilter selects the collections to compact. The zero value selects all
+// the collections with records to compact.
+type CompactionFilter struct {
+	MinPendingRecords int64
+	// MinAge is how long ago the first record to compact must have been pushed
+	MinAge   time.Duration
+	TenantID string
+	// Limit is the maximum number of collections returned, 0 is no limit
+	Limit int32
+}
+
+// GetAllCollectionInfoToCompact returns the collections with records to compact,
+// those with the oldest first record to compact first.
+func (r *LogRepository) GetAllCollectionInfoToCompact(ctx context.Context, filter CompactionFilter) (collectionToCompact []log.GetCollectionsToCompactRow, err error) {
+	minPendingRecords := filter.MinPendingRecords
+	if minPendingRecords < 1 {
+		minPendingRecords = 1
+	}
+	collectionToCompact, err = r.queries.GetCollectionsToCompact(ctx, log.GetCollectionsToCompactParams{
+		MinPendingRecords: minPendingRecords,
+		MaxFirstTimestamp: time.Now().Add(-filter.MinAge).UnixNano(),
+		TenantID:          pgtype.Text{String: filter.TenantID, Valid: filter.TenantID != ""},
+		MaxCount:          pgtype.Int4{Int32: filter.Limit, Valid: filter.Limit > 0},
+	})
 	if collectionToCompact == nil {
-		collectionToCompact = []log.GetAllCollectionsToCompactRow{}
+		collectionToCompact = []log.GetCollectionsToCompactRow{}
 	}
 	return
 }
-func (r *LogRepository) UpdateCollectionCompactionOffsetPosition(ctx context.Context, collectionId string, offsetPosition int64) (err error) {
-	err = r.queries.UpdateCollectionCompactionOffsetPosition(ctx, log.UpdateCollectionCompactionOffsetPositionParams{
+
+// UpdateCollectionCompactionOffsetPosition moves the compaction offset of a collection.
+// It fails with a CompactionOffsetError when the offset is after the last record, when
+// it is before the current compaction offset and allowRegression is not set or the
+// records after it are purged, or when expectedOffset is set and is not the current
+// compaction offset. The offset is remembered for the restore window, see
+// restorableOffset.
+func (r *LogRepository) UpdateCollectionCompactionOffsetPosition(ctx context.Context, collectionId string, offsetPosition int64, expectedOffset *int64, allowRegression bool) (err error) {
+	var tx pgx.Tx
+	tx, err = r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return
+	}
+	queriesWithTx := r.queries.WithTx(tx)
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+	var collection log.Collection
+	collection, err = queriesWithTx.GetCollectionForUpdate(ctx, collectionId)
+	if err != nil {
+		// a collection without records has both offsets at 0
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return
+		}
+		collection = log.Collection{ID: collectionId}
+		err = nil
+	}
+	firstOffset := int64(1)
+	if allowRegression && offsetPosition < collection.RecordCompactionOffsetPosition {
+		firstOffset, err = queriesWithTx.GetFirstRecordOffset(ctx, collectionId)
+		if err != nil {
+			return
+		}
+	}
+	err = checkCompactionOffset(collection, firstOffset, offsetPosition, expectedOffset, allowRegression)
+	if err != nil || offsetPosition == collection.RecordCompactionOffsetPosition {
+		return
+	}
+	err = queriesWithTx.UpdateCollectionCompactionOffsetPosition(ctx, log.UpdateCollectionCompactionOffsetPositionParams{
 		ID:                             collectionId,
 		RecordCompactionOffsetPosition: offsetPosition,
 	})
+	if err != nil {
+		return
+	}
+	now := time.Now().UnixNano()
+	err = queriesWithTx.InsertCompactionOffset(ctx, log.InsertCompactionOffsetParams{
+		CollectionID:   collectionId,
+		OffsetPosition: offsetPosition,
+		Timestamp:      now,
+	})
+	if err != nil {
+		return
+	}
+	err = queriesWithTx.DeleteExpiredCompactionOffsets(ctx, log.DeleteExpiredCompactionOffsetsParams{
+		CollectionID: collectionId,
+		Timestamp:    now - r.restoreWindow.Nanoseconds(),
+	})
 	return
 }
 
+// checkCompactionOffset checks a move of the compaction offset of a collection whose
+// first record that is not purged is at firstOffset.
+func checkCompactionOffset(collection log.Collection, firstOffset int64, offsetPosition int64, expectedOffset *int64, allowRegression bool) error {
+	var reason string
+	switch {
+	case expectedOffset != nil && *expectedOffset != collection.RecordCompactionOffsetPosition:
+		reason = fmt.Sprintf("expected compaction offset %d", *expectedOffset)
+	case offsetPosition > collection.RecordEnumerationOffsetPosition:
+		reason = "it is after the last record"
+	case offsetPosition < collection.RecordCompactionOffsetPosition && !allowRegression:
+		reason = "it is before the compaction offset"
+	case offsetPosition < collection.RecordCompactionOffsetPosition && offsetPosition+1 < firstOffset:
+		reason = "the records after it are purged"
+	default:
+		return nil
+	}
+	return &CompactionOffsetError{
+		CollectionID:      collection.ID,
+		Requested:         offsetPosition,
+		Reason:            reason,
+		CompactionOffset:  collection.RecordCompactionOffsetPosition,
+		EnumerationOffset: collection.RecordEnumerationOffsetPosition,
+	}
+}
+
+// compactionOffset is a compaction offset of a collection and when it was set.
+type compactionOffset struct {
+	Offset    int64 `json:"offset"`
+	Timestamp int64 `json:"timestamp"`
+}
+
+// addCompactionOffset remembers a compaction offset set at timestamp and forgets
+// the ones set before windowStart.
+func addCompactionOffset(offsets []compactionOffset, offset int64, timestamp int64, windowStart int64) []compactionOffset {
+	kept := offsets[:0]
+	for _, previous := range offsets {
+		if previous.Timestamp >= windowStart {
+			kept = append(kept, previous)
+		}
+	}
+	return append(kept, compactionOffset{Offset: offset, Timestamp: timestamp})
+}
+
+// restorableOffset returns the lowest compaction offset set since windowStart. The
+// sysdb can restore a collection to the log position of a version, which is the
+// compaction offset the version was made at, so the purger keeps the records after
+// it for the versions made in the restore window. ok is false when the compaction
+// offset did not move in the window.
+func restorableOffset(offsets []compactionOffset, windowStart int64) (offset int64, ok bool) {
+	for _, compaction := range offsets {
+		if compaction.Timestamp >= windowStart && (!ok || compaction.Offset < offset) {
+			offset, ok = compaction.Offset, true
+		}
+	}
+	return offset, ok
+}
+
+// lastPurgeableOffset returns the last compacted record of a collection that the
+// versions of the restore window do not need.
+func lastPurgeableOffset(compactionOffsetPosition int64, offsets []compactionOffset, windowStart int64) int64 {
+	last := compactionOffsetPosition - 1
+	if offset, ok := restorableOffset(offsets, windowStart); ok && offset < last {
+		last = offset
+	}
+	return last
+}
+
+// PurgeRecords deletes the compacted records that the versions of the restore
+// window do not need.
 func (r *LogRepository) PurgeRecords(ctx context.Context) (err error) {
-	err = r.queries.PurgeRecords(ctx)
+	err = r.queries.PurgeRecords(ctx, time.Now().UnixNano()-r.restoreWindow.Nanoseconds())
+	return
+}
+
+// PurgeRecordsBatch deletes up to batchSize compacted records that are out of the
+// retention window of their collection, defaultRetention applies to collections
+// without one. The records after the compaction offsets of the restore window are
+// kept for the restores of the versions made at them.
+func (r *LogRepository) PurgeRecordsBatch(ctx context.Context, batchSize int32, defaultRetention Retention) (purgeCount int64, err error) {
+	now := time.Now().UnixNano()
+	purgeCount, err = r.queries.PurgeRecordsBatch(ctx, log.PurgeRecordsBatchParams{
+		RestoreWindowStart:            now - r.restoreWindow.Nanoseconds(),
+		Now:                           now,
+		DefaultRetentionPeriodSeconds: defaultRetention.PeriodSeconds,
+		DefaultRetentionRecordCount:   defaultRetention.RecordCount,
+		BatchSize:                     batchSize,
+	})
+	return
+}
+
+// PurgeIdempotencyKeysBatch deletes up to batchSize keys of pushes out of the
+// idempotency window of any collection. A push with a key only expires the keys of
+// its own collection, the purger deletes the keys of the collections that are no
+// longer pushed to with a key.
+func (r *LogRepository) PurgeIdempotencyKeysBatch(ctx context.Context, batchSize int32) (purgeCount int64, err error) {
+	purgeCount, err = r.queries.PurgeIdempotencyKeysBatch(ctx, log.PurgeIdempotencyKeysBatchParams{
+		Timestamp: time.Now().UnixNano() - r.idempotencyWindow.Nanoseconds(),
+		BatchSize: batchSize,
+	})
+	return
+}
+
+// SetCollectionRetention sets the retention window of a collection, a nil value
+// falls back to the default of the purger.
+func (r *LogRepository) SetCollectionRetention(ctx context.Context, collectionId string, periodSeconds *int64, recordCount *int64) (err error) {
+	var deleted bool
+	deleted, err = r.queries.IsCollectionTombstoned(ctx, collectionId)
+	if err != nil {
+		return
+	}
+	if deleted {
+		err = ErrCollectionDeleted
+		return
+	}
+	params := log.UpsertCollectionRetentionParams{
+		ID: collectionId,
+	}
+	if periodSeconds != nil {
+		params.RetentionPeriodSeconds = pgtype.Int8{Int64: *periodSeconds, Valid: true}
+	}
+	if recordCount != nil {
+		params.RetentionRecordCount = pgtype.Int8{Int64: *recordCount, Valid: true}
+	}
+	err = r.queries.UpsertCollectionRetention(ctx, params)
+	return
+}
+
+// DeleteCollection drops the records and the offsets of a collection and leaves a
+// tombstone, so that later pushes to the collection fail with ErrCollectionDeleted.
+// Deleting a deleted collection does nothing.
+func (r *LogRepository) DeleteCollection(ctx context.Context, collectionId string) (deletedCount int64, err error) {
+	var tx pgx.Tx
+	tx, err = r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return
+	}
+	queriesWithTx := r.queries.WithTx(tx)
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+	// wait for the pushes in flight
+	_, err = queriesWithTx.GetCollectionForUpdate(ctx, collectionId)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return
+		}
+		err = nil
+	}
+	err = queriesWithTx.InsertCollectionTombstone(ctx, log.InsertCollectionTombstoneParams{
+		CollectionID: collectionId,
+		DeletedAt:    time.Now().UnixNano(),
+	})
+	if err != nil {
+		return
+	}
+	deletedCount, err = queriesWithTx.DeleteRecordsForCollection(ctx, collectionId)
+	if err != nil {
+		return
+	}
+	err = queriesWithTx.DeleteIdempotencyKeysForCollection(ctx, collectionId)
+	if err != nil {
+		return
+	}
+	err = queriesWithTx.DeleteCompactionOffsetsForCollection(ctx, collectionId)
+	if err != nil {
+		return
+	}
+	err = queriesWithTx.DeleteCollection(ctx, collectionId)
 	return
 }
 
-func NewLogRepository(conn *pgx.Conn) *LogRepository {
+// NewLogRepository creates a store keeping the logs in Postgres. The keys of pushes
+// are remembered for idempotencyWindow and the compacted records needed to restore
+// the versions made in the last restoreWindow are kept.
+func NewLogRepository(pool *pgxpool.Pool, idempotencyWindow time.Duration, restoreWindow time.Duration, quota Quota) *LogRepository {
 	return &LogRepository{
-		conn:    conn,
-		queries: log.New(conn),
+		pool:              pool,
+		queries:           log.New(pool),
+		idempotencyWindow: idempotencyWindow,
+		restoreWindow:     restoreWindow,
+		quota:             quota,
 	}
 }
@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"context"
+	log "github.com/chroma-core/chroma/go/database/log/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"sort"
+	"sync"
+	"time"
+)
+
+type memoryCollection struct {
+	log.Collection
+	// records are ordered by offset
+	records         []log.RecordLog
+	idempotencyKeys map[string]log.PushIdempotencyKey
+	// compactionOffsets are the compaction offsets of the restore window
+	compactionOffsets []compactionOffset
+}
+
+// MemoryLogStore keeps the logs in memory for local development and tests. It has
+// the ordering and offset guarantees of LogRepository, and loses everything when
+// the process stops.
+type MemoryLogStore struct {
+	// idempotencyWindow is how long the key of a push is remembered
+	idempotencyWindow time.Duration
+	// restoreWindow is how long the versions made by a compaction stay restorable
+	restoreWindow time.Duration
+	quota         Quota
+
+	mu          sync.RWMutex
+	collections map[string]*memoryCollection
+	// tombstones are the deletion times of the deleted collections
+	tombstones map[string]int64
+}
+
+func NewMemoryLogStore(idempotencyWindow time.Duration, restoreWindow time.Duration, quota Quota) *MemoryLogStore {
+	return &MemoryLogStore{
+		idempotencyWindow: idempotencyWindow,
+		restoreWindow:     restoreWindow,
+		quota:             quota,
+		collections:       make(map[string]*memoryCollection),
+		tombstones:        make(map[string]int64),
+	}
+}
+
+// getOrCreateCollection returns the collection, creating it unless it was deleted.
+// The caller holds the write lock.
+func (m *MemoryLogStore) getOrCreateCollection(collectionId string) (*memoryCollection, error) {
+	if collection, ok := m.collections[collectionId]; ok {
+		return collection, nil
+	}
+	if _, deleted := m.tombstones[collectionId]; deleted {
+		return nil, ErrCollectionDeleted
+	}
+	collection := &memoryCollection{
+		Collection:      log.Collection{ID: collectionId},
+		idempotencyKeys: make(map[string]log.PushIdempotencyKey),
+	}
+	m.collections[collectionId] = collection
+	return collection, nil
+}
+
+func (m *MemoryLogStore) InsertRecords(ctx context.Context, collectionId string, tenantId string, records [][]byte, idempotencyKey string) (InsertedRecords, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	collection, err := m.getOrCreateCollection(collectionId)
+	if err != nil {
+		return InsertedRecords{}, err
+	}
+	if tenantId != "" {
+		collection.TenantID = pgtype.Text{String: tenantId, Valid: true}
+	}
+	now := time.Now().UnixNano()
+	if idempotencyKey != "" {
+		windowStart := now - m.idempotencyWindow.Nanoseconds()
+		expireIdempotencyKeys(collection.idempotencyKeys, windowStart, -1)
+		if pushed, ok := collection.idempotencyKeys[idempotencyKey]; ok {
+			return InsertedRecords{
+				Count:       pushed.RecordCount,
+				FirstOffset: pushed.FirstOffset,
+				LastOffset:  pushed.FirstOffset + pushed.RecordCount - 1,
+				Timestamp:   pushed.Timestamp,
+			}, nil
+		}
+	}
+	var insertBytes int64
+	for _, record := range records {
+		insertBytes += int64(len(record))
+	}
+	if err := m.checkQuota(collection, tenantId, int64(len(records)), insertBytes); err != nil {
+		return InsertedRecords{}, err
+	}
+	firstOffset := collection.RecordEnumerationOffsetPosition + 1
+	for i, record := range records {
+		collection.records = append(collection.records, log.RecordLog{
+			Offset:       firstOffset + int64(i),
+			CollectionID: collectionId,
+			Timestamp:    now,
+			Record:       append([]byte(nil), record...),
+			Checksum:     recordChecksum(record),
+		})
+	}
+	insertCount := int64(len(records))
+	collection.RecordEnumerationOffsetPosition += insertCount
+	collection.UncompactedBytes += insertBytes
+	if idempotencyKey != "" {
+		collection.idempotencyKeys[idempotencyKey] = log.PushIdempotencyKey{
+			CollectionID:   collectionId,
+			IdempotencyKey: idempotencyKey,
+			FirstOffset:    firstOffset,
+			RecordCount:    insertCount,
+			Timestamp:      now,
+		}
+	}
+	return InsertedRecords{
+		Count:       insertCount,
+		FirstOffset: firstOffset,
+		LastOffset:  firstOffset + insertCount - 1,
+		Timestamp:   now,
+	}, nil
+}
+
+// checkQuota is LogRepository.checkQuota. The caller holds the write lock.
+func (m *MemoryLogStore) checkQuota(collection *memoryCollection, tenantId string, records int64, bytes int64) error {
+	collectionRecords := collection.RecordEnumerationOffsetPosition - collection.RecordCompactionOffsetPosition
+	if err := exceedsQuota("collection", collection.ID, "records", m.quota.MaxCollectionRecords, collectionRecords, records); err != nil {
+		return err
+	}
+	if err := exceedsQuota("collection", collection.ID, "bytes", m.quota.MaxCollectionBytes, collection.UncompactedBytes, bytes); err != nil {
+		return err
+	}
+	if tenantId == "" {
+		return nil
+	}
+	var tenantRecords, tenantBytes int64
+	for _, other := range m.collections {
+		if other.TenantID.Valid && other.TenantID.String == tenantId {
+			tenantRecords += other.RecordEnumerationOffsetPosition - other.RecordCompactionOffsetPosition
+			tenantBytes += other.UncompactedBytes
+		}
+	}
+	if err := exceedsQuota("tenant", tenantId, "records", m.quota.MaxTenantRecords, tenantRecords, records); err != nil {
+		return err
+	}
+	return exceedsQuota("tenant", tenantId, "bytes", m.quota.MaxTenantBytes, tenantBytes, bytes)
+}
+
+func (m *MemoryLogStore) PullRecords(ctx context.Context, collectionId string, offset int64, batchSize int, timestamp int64) ([]log.RecordLog, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	collection, ok := m.collections[collectionId]
+	if !ok {
+		return nil, nil
+	}
+	start := sort.Search(len(collection.records), func(i int) bool {
+		return collection.records[i].Offset >= offset
+	})
+	var records []log.RecordLog
+	for _, record := range collection.records[start:] {
+		if len(records) >= batchSize {
+			break
+		}
+		if record.Timestamp <= timestamp {
+			records = append(records, record)
+		}
+	}
+	return verifyRecords(collectionId, records)
+}
+
+func (m *MemoryLogStore) GetAllCollectionInfoToCompact(ctx context.Context, filter CompactionFilter) ([]log.GetCollectionsToCompactRow, error) {
+	minPendingRecords := filter.MinPendingRecords
+	if minPendingRecords < 1 {
+		minPendingRecords = 1
+	}
+	maxFirstTimestamp := time.Now().Add(-filter.MinAge).UnixNano()
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	collectionToCompact := []log.GetCollectionsToCompactRow{}
+	for _, collection := range m.collections {
+		pendingRecords := collection.RecordEnumerationOffsetPosition - collection.RecordCompactionOffsetPosition
+		if pendingRecords < minPendingRecords {
+			continue
+		}
+		if filter.TenantID != "" && collection.TenantID.String != filter.TenantID {
+			continue
+		}
+		first := sort.Search(len(collection.records), func(i int) bool {
+			return collection.records[i].Offset > collection.RecordCompactionOffsetPosition
+		})
+		if first == len(collection.records) || collection.records[first].Timestamp > maxFirstTimestamp {
+			continue
+		}
+		collectionToCompact = append(collectionToCompact, log.GetCollectionsToCompactRow{
+			CollectionID:   collection.ID,
+			TenantID:       collection.TenantID,
+			FirstOffset:    collection.records[first].Offset,
+			FirstTimestamp: collection.records[first].Timestamp,
+			LatestOffset:   collection.RecordEnumerationOffsetPosition,
+			PendingRecords: pendingRecords,
+			PendingBytes:   collection.UncompactedBytes,
+		})
+	}
+	sort.Slice(collectionToCompact, func(i, j int) bool {
+		if collectionToCompact[i].FirstTimestamp != collectionToCompact[j].FirstTimestamp {
+			return collectionToCompact[i].FirstTimestamp < collectionToCompact[j].FirstTimestamp
+		}
+		return collectionToCompact[i].CollectionID < collectionToCompact[j].CollectionID
+	})
+	if filter.Limit > 0 && len(collectionToCompact) > int(filter.Limit) {
+		collectionToCompact = collectionToCompact[:filter.Limit]
+	}
+	return collectionToCompact, nil
+}
+
+func (m *MemoryLogStore) UpdateCollectionCompactionOffsetPosition(ctx context.Context, collectionId string, offsetPosition int64, expectedOffset *int64, allowRegression bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	collection, ok := m.collections[collectionId]
+	if !ok {
+		// a collection without records has both offsets at 0
+		return checkCompactionOffset(log.Collection{ID: collectionId}, 1, offsetPosition, expectedOffset, allowRegression)
+	}
+	firstOffset := int64(1)
+	if len(collection.records) > 0 {
+		firstOffset = collection.records[0].Offset
+	}
+	if err := checkCompactionOffset(collection.Collection, firstOffset, offsetPosition, expectedOffset, allowRegression); err != nil {
+		return err
+	}
+	if offsetPosition == collection.RecordCompactionOffsetPosition {
+		return nil
+	}
+	now := time.Now().UnixNano()
+	collection.RecordCompactionOffsetPosition = offsetPosition
+	collection.compactionOffsets = addCompactionOffset(collection.compactionOffsets, offsetPosition, now, now-m.restoreWindow.Nanoseconds())
+	collection.UncompactedBytes = 0
+	for _, record := range collection.records {
+		if record.Offset > offsetPosition {
+			collection.UncompactedBytes += int64(len(record.Record))
+		}
+	}
+	return nil
+}
+
+func (m *MemoryLogStore) PurgeRecords(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	windowStart := time.Now().UnixNano() - m.restoreWindow.Nanoseconds()
+	for _, collection := range m.collections {
+		lastOffset := lastPurgeableOffset(collection.RecordCompactionOffsetPosition, collection.compactionOffsets, windowStart)
+		collection.records = filterRecords(collection.records, -1, func(record log.RecordLog) bool {
+			return record.Offset <= lastOffset
+		})
+	}
+	return nil
+}
+
+func (m *MemoryLogStore) PurgeRecordsBatch(ctx context.Context, batchSize int32, defaultRetention Retention) (int64, error) {
+	now := time.Now().UnixNano()
+	windowStart := now - m.restoreWindow.Nanoseconds()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var purgeCount int64
+	for _, collection := range m.collections {
+		if purgeCount >= int64(batchSize) {
+			break
+		}
+		lastOffset := lastPurgeableOffset(collection.RecordCompactionOffsetPosition, collection.compactionOffsets, windowStart)
+		periodSeconds := defaultRetention.PeriodSeconds
+		if collection.RetentionPeriodSeconds.Valid {
+			periodSeconds = collection.RetentionPeriodSeconds.Int64
+		}
+		recordCount := defaultRetention.RecordCount
+		if collection.RetentionRecordCount.Valid {
+			recordCount = collection.RetentionRecordCount.Int64
+		}
+		before := len(collection.records)
+		collection.records = filterRecords(collection.records, int64(batchSize)-purgeCount, func(record log.RecordLog) bool {
+			return record.Offset <= lastOffset &&
+				record.Timestamp <= now-periodSeconds*int64(time.Second) &&
+				record.Offset <= collection.RecordEnumerationOffsetPosition-recordCount
+		})
+		purgeCount += int64(before - len(collection.records))
+	}
+	return purgeCount, nil
+}
+
+// PurgeIdempotencyKeysBatch is LogRepository.PurgeIdempotencyKeysBatch.
+func (m *MemoryLogStore) PurgeIdempotencyKeysBatch(ctx context.Context, batchSize int32) (int64, error) {
+	windowStart := time.Now().UnixNano() - m.idempotencyWindow.Nanoseconds()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var purgeCount int64
+	for _, collection := range m.collections {
+		if purgeCount >= int64(batchSize) {
+			break
+		}
+		purgeCount += expireIdempotencyKeys(collection.idempotencyKeys, windowStart, int64(batchSize)-purgeCount)
+	}
+	return purgeCount, nil
+}
+
+// expireIdempotencyKeys drops up to limit keys pushed before windowStart, all of
+// them when limit is negative, and returns how many it dropped.
+func expireIdempotencyKeys(keys map[string]log.PushIdempotencyKey, windowStart int64, limit int64) int64 {
+	var count int64
+	for key, pushed := range keys {
+		if count == limit {
+			break
+		}
+		if pushed.Timestamp < windowStart {
+			delete(keys, key)
+			count++
+		}
+	}
+	return count
+}
+
+// filterRecords drops up to limit records matching purge, all of them when limit is
+// negative.
+func filterRecords(records []log.RecordLog, limit int64, purge func(log.RecordLog) bool) []log.RecordLog {
+	kept := records[:0]
+	for _, record := range records {
+		if limit != 0 && purge(record) {
+			limit--
+			continue
+		}
+		kept = append(kept, record)
+	}
+	return kept
+}
+
+func (m *MemoryLogStore) SetCollectionRetention(ctx context.Context, collectionId string, periodSeconds *int64, recordCount *int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	collection, err := m.getOrCreateCollection(collectionId)
+	if err != nil {
+		return err
+	}
+	collection.RetentionPeriodSeconds = pgtype.Int8{}
+	if periodSeconds != nil {
+		collection.RetentionPeriodSeconds = pgtype.Int8{Int64: *periodSeconds, Valid: true}
+	}
+	collection.RetentionRecordCount = pgtype.Int8{}
+	if recordCount != nil {
+		collection.RetentionRecordCount = pgtype.Int8{Int64: *recordCount, Valid: true}
+	}
+	return nil
+}
+
+func (m *MemoryLogStore) DeleteCollection(ctx context.Context, collectionId string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, deleted := m.tombstones[collectionId]; !deleted {
+		m.tombstones[collectionId] = time.Now().UnixNano()
+	}
+	collection, ok := m.collections[collectionId]
+	if !ok {
+		return 0, nil
+	}
+	delete(m.collections, collectionId)
+	return int64(len(collection.records)), nil
+}
+
+func (m *MemoryLogStore) VerifyCollectionLog(ctx context.Context, collectionId string) (LogVerification, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	collection, ok := m.collections[collectionId]
+	if !ok {
+		return newLogVerifier(0, 0).finish(), nil
+	}
+	verifier := newLogVerifier(collection.RecordCompactionOffsetPosition, collection.RecordEnumerationOffsetPosition)
+	for _, record := range collection.records {
+		verifier.addRecord(record.Offset, record.Record, record.Checksum)
+	}
+	return verifier.finish(), nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	log "github.com/chroma-core/chroma/go/database/log/db"
+)
+
+// LogStore keeps the logs of the collections. The records of a collection have
+// consecutive offsets from 1 in the order they were inserted, and an offset is
+// never reused. LogRepository keeps them in Postgres, FileLogStore in files on the local disk and
+// MemoryLogStore in memory.
+type LogStore interface {
+	// InsertRecords appends records to the log of a collection, see LogRepository.InsertRecords.
+	InsertRecords(ctx context.Context, collectionId string, tenantId string, records [][]byte, idempotencyKey string) (InsertedRecords, error)
+	// PullRecords returns up to batchSize records from offset on that are not newer than timestamp.
+	// The records stop before one that fails its checksum, a ChecksumError when it is the first one.
+	PullRecords(ctx context.Context, collectionId string, offset int64, batchSize int, timestamp int64) ([]log.RecordLog, error)
+	// GetAllCollectionInfoToCompact returns the collections with records to compact.
+	GetAllCollectionInfoToCompact(ctx context.Context, filter CompactionFilter) ([]log.GetCollectionsToCompactRow, error)
+	// UpdateCollectionCompactionOffsetPosition moves the compaction offset of a collection.
+	UpdateCollectionCompactionOffsetPosition(ctx context.Context, collectionId string, offsetPosition int64, expectedOffset *int64, allowRegression bool) error
+	// PurgeRecords deletes every compacted record the versions of the restore window do not need.
+	PurgeRecords(ctx context.Context) error
+	// PurgeRecordsBatch deletes up to batchSize compacted records out of their retention window.
+	PurgeRecordsBatch(ctx context.Context, batchSize int32, defaultRetention Retention) (int64, error)
+	// PurgeIdempotencyKeysBatch deletes up to batchSize push keys out of the idempotency window.
+	PurgeIdempotencyKeysBatch(ctx context.Context, batchSize int32) (int64, error)
+	// SetCollectionRetention sets the retention window of a collection.
+	SetCollectionRetention(ctx context.Context, collectionId string, periodSeconds *int64, recordCount *int64) error
+	// DeleteCollection drops the log of a collection and rejects later writes to it.
+	DeleteCollection(ctx context.Context, collectionId string) (int64, error)
+	// VerifyCollectionLog checks the records of a collection against their checksums and offsets.
+	VerifyCollectionLog(ctx context.Context, collectionId string) (LogVerification, error)
+}
+
+var _ LogStore = &LogRepository{}
+var _ LogStore = &MemoryLogStore{}
+var _ LogStore = &FileLogStore{}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"fmt"
+	log "github.com/chroma-core/chroma/go/database/log/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"hash/crc32"
+)
+
+// maxLogIssues bounds the issues listed by a verification, IssueCount counts them all
+const maxLogIssues = 1000
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// recordChecksum is the CRC-32C of a record.
+func recordChecksum(record []byte) pgtype.Int8 {
+	return pgtype.Int8{Int64: int64(crc32.Checksum(record, crcTable)), Valid: true}
+}
+
+// ChecksumError is returned when a record read from the log does not match the
+// checksum it was written with.
+type ChecksumError struct {
+	CollectionID string
+	Offset       int64
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("record %d of collection %s does not match its checksum", e.Offset, e.CollectionID)
+}
+
+// verifyRecords checks the records against their checksum and returns the ones
+// before the first that does not match, so that a reader gets the records up to a
+// corrupt one. It is a ChecksumError when the first record does not match. The
+// records without a checksum were written before the checksums were added.
+func verifyRecords(collectionId string, records []log.RecordLog) ([]log.RecordLog, error) {
+	for i, record := range records {
+		if record.Checksum.Valid && record.Checksum != recordChecksum(record.Record) {
+			if i == 0 {
+				return nil, &ChecksumError{CollectionID: collectionId, Offset: record.Offset}
+			}
+			return records[:i], nil
+		}
+	}
+	return records, nil
+}
+
+type LogIssueKind int
+
+const (
+	// LogIssueChecksumMismatch is a record that does not match its checksum
+	LogIssueChecksumMismatch LogIssueKind = iota
+	// LogIssueOffsetGap is offsets missing after the compaction offset
+	LogIssueOffsetGap
+	// LogIssueOffsetBeyondEnumeration is a record after the enumeration offset
+	LogIssueOffsetBeyondEnumeration
+)
+
+type LogIssue struct {
+	Kind LogIssueKind
+	// Offset is the offset of the record, or the first missing offset of a gap
+	Offset      int64
+	Description string
+}
+
+// LogVerification is the result of a scan of the log of a collection. The records
+// up to the compaction offset may be purged, so only the offsets after it are
+// expected to be all there.
+type LogVerification struct {
+	CompactionOffset  int64
+	EnumerationOffset int64
+	RecordCount       int64
+	// UncheckedRecordCount is the records written without a checksum
+	UncheckedRecordCount int64
+	// FirstOffset and LastOffset are 0 when there is no record
+	FirstOffset int64
+	LastOffset  int64
+	IssueCount  int64
+	Issues      []LogIssue
+}
+
+// logVerifier collects the issues of the records of a collection, read in the
+// order of their offsets.
+type logVerifier struct {
+	verification LogVerification
+}
+
+func newLogVerifier(compactionOffset int64, enumerationOffset int64) *logVerifier {
+	return &logVerifier{
+		verification: LogVerification{
+			CompactionOffset:  compactionOffset,
+			EnumerationOffset: enumerationOffset,
+		},
+	}
+}
+
+func (v *logVerifier) report(kind LogIssueKind, offset int64, description string) {
+	v.verification.IssueCount++
+	if len(v.verification.Issues) < maxLogIssues {
+		v.verification.Issues = append(v.verification.Issues, LogIssue{
+			Kind:        kind,
+			Offset:      offset,
+			Description: description,
+		})
+	}
+}
+
+// reportGap reports the offsets from first to last, the ones up to the compaction
+// offset and after the enumeration offset are not expected.
+func (v *logVerifier) reportGap(first int64, last int64) {
+	first = max(first, v.verification.CompactionOffset+1)
+	last = min(last, v.verification.EnumerationOffset)
+	if first > last {
+		return
+	}
+	v.report(LogIssueOffsetGap, first, fmt.Sprintf("offsets %d to %d are missing", first, last))
+}
+
+// add checks the offset of the next record.
+func (v *logVerifier) add(offset int64) {
+	verification := &v.verification
+	if verification.RecordCount == 0 {
+		verification.FirstOffset = offset
+		v.reportGap(1, offset-1)
+	} else {
+		v.reportGap(verification.LastOffset+1, offset-1)
+	}
+	if offset > verification.EnumerationOffset {
+		v.report(LogIssueOffsetBeyondEnumeration, offset, fmt.Sprintf("the record is after the enumeration offset %d", verification.EnumerationOffset))
+	}
+	verification.RecordCount++
+	verification.LastOffset = offset
+}
+
+// addRecord checks the next record against its checksum.
+func (v *logVerifier) addRecord(offset int64, record []byte, checksum pgtype.Int8) {
+	v.add(offset)
+	if !checksum.Valid {
+		v.verification.UncheckedRecordCount++
+		return
+	}
+	if checksum != recordChecksum(record) {
+		v.report(LogIssueChecksumMismatch, offset, "the record does not match its checksum")
+	}
+}
+
+// finish reports the offsets missing after the last record.
+func (v *logVerifier) finish() LogVerification {
+	v.reportGap(v.verification.LastOffset+1, v.verification.EnumerationOffset)
+	return v.verification
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogVerifier(t *testing.T) {
+	verifier := newLogVerifier(2, 8)
+	// the records up to the compaction offset may be purged
+	verifier.addRecord(2, []byte("b"), recordChecksum([]byte("b")))
+	verifier.addRecord(3, []byte("c"), recordChecksum([]byte("x")))
+	verifier.addRecord(6, []byte("f"), pgtype.Int8{})
+	verifier.addRecord(9, []byte("i"), recordChecksum([]byte("i")))
+	verification := verifier.finish()
+	assert.Equal(t, int64(4), verification.RecordCount)
+	assert.Equal(t, int64(1), verification.UncheckedRecordCount)
+	assert.Equal(t, int64(2), verification.FirstOffset)
+	assert.Equal(t, int64(9), verification.LastOffset)
+	assert.Equal(t, int64(4), verification.IssueCount)
+	assert.Equal(t, []LogIssue{
+		{Kind: LogIssueChecksumMismatch, Offset: 3, Description: "the record does not match its checksum"},
+		{Kind: LogIssueOffsetGap, Offset: 4, Description: "offsets 4 to 5 are missing"},
+		{Kind: LogIssueOffsetGap, Offset: 7, Description: "offsets 7 to 8 are missing"},
+		{Kind: LogIssueOffsetBeyondEnumeration, Offset: 9, Description: "the record is after the enumeration offset 8"},
+	}, verification.Issues)
+}
+
+func TestLogVerifier_MissingRecords(t *testing.T) {
+	verifier := newLogVerifier(1, 3)
+	verification := verifier.finish()
+	assert.Equal(t, []LogIssue{
+		{Kind: LogIssueOffsetGap, Offset: 2, Description: "offsets 2 to 3 are missing"},
+	}, verification.Issues)
+
+	// every other record is missing, only the first issues are listed
+	verifier = newLogVerifier(0, 2*maxLogIssues+20)
+	for offset := int64(1); offset <= 2*maxLogIssues+20; offset += 2 {
+		verifier.addRecord(offset, nil, recordChecksum(nil))
+	}
+	verification = verifier.finish()
+	assert.Equal(t, int64(maxLogIssues+10), verification.IssueCount)
+	assert.Len(t, verification.Issues, maxLogIssues)
+}
+
+func TestMemoryLogStore_Checksum(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryLogStore(time.Minute, time.Hour, Quota{})
+	_, err := store.InsertRecords(ctx, "c1", "", [][]byte{[]byte("a"), []byte("b"), []byte("c")}, "")
+	assert.NoError(t, err)
+	store.collections["c1"].records[1].Record[0] ^= 0xff
+
+	// the batch ends before the corrupt record, which fails the next pull
+	records, err := store.PullRecords(ctx, "c1", 1, 10, time.Now().UnixNano())
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	_, err = store.PullRecords(ctx, "c1", 2, 10, time.Now().UnixNano())
+	var checksumErr *ChecksumError
+	if assert.ErrorAs(t, err, &checksumErr) {
+		assert.Equal(t, int64(2), checksumErr.Offset)
+	}
+	verification, err := store.VerifyCollectionLog(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), verification.RecordCount)
+	assert.Equal(t, []LogIssue{
+		{Kind: LogIssueChecksumMismatch, Offset: 2, Description: "the record does not match its checksum"},
+	}, verification.Issues)
+}
+
+func TestMemoryLogStore_PurgeIdempotencyKeysBatch(t *testing.T) {
+	store := NewMemoryLogStore(50*time.Millisecond, time.Hour, Quota{})
+	testPurgeIdempotencyKeysBatch(t, store)
+}
+
+func TestMemoryLogStore_PurgeRestoreWindow(t *testing.T) {
+	store := NewMemoryLogStore(time.Minute, 50*time.Millisecond, Quota{})
+	testPurgeRestoreWindow(t, store)
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net"
+	"strings"
+)
+
+// toGrpcError maps an error of the repository to a gRPC status. Writes to a
+// deleted collection are NotFound, rejected offset updates are
+// FailedPrecondition and records failing their checksum are DataLoss. Errors
+// that go away on retry, such as a lost connection or a serialization failure,
+// are Unavailable, the others are Internal. Errors that already are a status are
+// returned as is.
+func toGrpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, repository.ErrCollectionDeleted) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	var offsetErr *repository.CompactionOffsetError
+	if errors.As(err, &offsetErr) {
+		grpcError, buildErr := grpcutils.BuildFailedPreconditionGrpcError("COMPACTION_OFFSET", offsetErr.CollectionID, offsetErr.Error())
+		if buildErr != nil {
+			return buildErr
+		}
+		return grpcError
+	}
+	var checksumErr *repository.ChecksumError
+	if errors.As(err, &checksumErr) {
+		return status.Error(codes.DataLoss, err.Error())
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if isTransientPgError(pgErr) {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		return grpcutils.BuildInternalGrpcError(err.Error())
+	}
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	if errors.As(err, &connectErr) || errors.As(err, &netErr) || pgconn.Timeout(err) || pgconn.SafeToRetry(err) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return grpcutils.BuildInternalGrpcError(err.Error())
+}
+
+func isTransientPgError(pgErr *pgconn.PgError) bool {
+	switch {
+	// connection exception
+	case strings.HasPrefix(pgErr.Code, "08"):
+		return true
+	// insufficient resources
+	case strings.HasPrefix(pgErr.Code, "53"):
+		return true
+	// operator intervention, such as a shutdown
+	case strings.HasPrefix(pgErr.Code, "57P"):
+		return true
+	// serialization failure and deadlock
+	case pgErr.Code == "40001" || pgErr.Code == "40P01":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToGrpcError(t *testing.T) {
+	assert.NoError(t, toGrpcError(nil))
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{status.Error(codes.ResourceExhausted, "quota"), codes.ResourceExhausted},
+		{fmt.Errorf("query: %w", context.Canceled), codes.Canceled},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{&pgconn.PgError{Code: "08006"}, codes.Unavailable},
+		{&pgconn.PgError{Code: "57P01"}, codes.Unavailable},
+		{&pgconn.PgError{Code: "40001"}, codes.Unavailable},
+		{&pgconn.PgError{Code: "23505"}, codes.Internal},
+		{repository.ErrCollectionDeleted, codes.NotFound},
+		{&repository.CompactionOffsetError{CollectionID: "c1", Requested: 4, Reason: "it is after the last record"}, codes.FailedPrecondition},
+		{&repository.ChecksumError{CollectionID: "c1", Offset: 3}, codes.DataLoss},
+		{errors.New("unexpected"), codes.Internal},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.code, status.Code(toGrpcError(test.err)), test.err.Error())
+	}
+}
@@ -0,0 +1,66 @@
+package server
+
+import "sync"
+
+// collectionNotifier wakes up the streams that tail a collection when records are
+// pushed to it. It only sees the pushes handled by this process, so the streams
+// also poll for records pushed through other log service instances.
+type collectionNotifier struct {
+	mu      sync.Mutex
+	waiters map[string]*collectionWaiters
+}
+
+// collectionWaiters are the streams tailing a collection.
+type collectionWaiters struct {
+	streams int
+	// pushed is closed by the next notify, it is nil until a stream waits
+	pushed chan struct{}
+}
+
+func newCollectionNotifier() *collectionNotifier {
+	return &collectionNotifier{
+		waiters: make(map[string]*collectionWaiters),
+	}
+}
+
+// subscribe registers a stream tailing the collection until the returned function
+// is called, once. The collection is forgotten when its last stream leaves.
+func (n *collectionNotifier) subscribe(collectionID string) (unsubscribe func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	waiters, ok := n.waiters[collectionID]
+	if !ok {
+		waiters = &collectionWaiters{}
+		n.waiters[collectionID] = waiters
+	}
+	waiters.streams++
+	return func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		waiters.streams--
+		if waiters.streams == 0 {
+			delete(n.waiters, collectionID)
+		}
+	}
+}
+
+// wait returns a channel that is closed by the next notify for the collection. The
+// caller is subscribed to the collection.
+func (n *collectionNotifier) wait(collectionID string) <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	waiters := n.waiters[collectionID]
+	if waiters.pushed == nil {
+		waiters.pushed = make(chan struct{})
+	}
+	return waiters.pushed
+}
+
+func (n *collectionNotifier) notify(collectionID string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if waiters, ok := n.waiters[collectionID]; ok && waiters.pushed != nil {
+		close(waiters.pushed)
+		waiters.pushed = nil
+	}
+}
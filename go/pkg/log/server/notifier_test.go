@@ -0,0 +1,66 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionNotifier(t *testing.T) {
+	notifier := newCollectionNotifier()
+	defer notifier.subscribe("collection")()
+	defer notifier.subscribe("other")()
+
+	first := notifier.wait("collection")
+	second := notifier.wait("collection")
+	other := notifier.wait("other")
+	notifier.notify("collection")
+
+	// every waiter of the collection is woken up
+	for _, ch := range []<-chan struct{}{first, second} {
+		select {
+		case <-ch:
+		default:
+			t.Fatal("waiter was not notified")
+		}
+	}
+	select {
+	case <-other:
+		t.Fatal("waiter of another collection was notified")
+	default:
+	}
+
+	// a wait after the notify waits for the next one
+	next := notifier.wait("collection")
+	select {
+	case <-next:
+		t.Fatal("waiter was notified by an earlier push")
+	default:
+	}
+	notifier.notify("collection")
+	_, open := <-next
+	assert.False(t, open)
+
+	// notifying a collection nobody waits for is a no-op
+	notifier.notify("unknown")
+}
+
+func TestCollectionNotifier_Unsubscribe(t *testing.T) {
+	notifier := newCollectionNotifier()
+	unsubscribeFirst := notifier.subscribe("collection")
+	unsubscribeSecond := notifier.subscribe("collection")
+	notifier.wait("collection")
+
+	// the collection is kept until its last stream leaves, notified or not
+	unsubscribeFirst()
+	assert.Len(t, notifier.waiters, 1)
+	unsubscribeSecond()
+	assert.Empty(t, notifier.waiters)
+
+	unsubscribe := notifier.subscribe("collection")
+	pushed := notifier.wait("collection")
+	notifier.notify("collection")
+	<-pushed
+	unsubscribe()
+	assert.Empty(t, notifier.waiters)
+}
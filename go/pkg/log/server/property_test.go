@@ -9,10 +9,16 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	libs2 "github.com/chroma-core/chroma/go/shared/libs"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"pgregory.net/rapid"
+	"sync"
 	"testing"
 	"time"
 )
@@ -21,6 +27,9 @@ type ModelState struct {
 	CollectionEnumerationOffset map[types.UniqueID]int64
 	CollectionData              map[types.UniqueID][]*coordinatorpb.OperationRecord
 	CollectionCompactionOffset  map[types.UniqueID]int64
+	// CollectionRestorableOffset is the first compaction offset of a collection,
+	// the suite runs within the restore window so its records are never purged
+	CollectionRestorableOffset map[types.UniqueID]int64
 }
 
 type LogServerTestSuite struct {
@@ -28,25 +37,54 @@ type LogServerTestSuite struct {
 	logServer logservicepb.LogServiceServer
 	model     ModelState
 	t         *testing.T
-	lr        *repository.LogRepository
+	lr        repository.LogStore
+	// newStore creates a store of the backend under test, the stores of the
+	// postgres backend share their database
+	newStore func(quota repository.Quota) repository.LogStore
 }
 
 func (suite *LogServerTestSuite) SetupSuite() {
 	ctx := context.Background()
 	config := configuration.NewLogServiceConfiguration()
-	connectionString, err := libs2.StartPgContainer(ctx)
-	config.DATABASE_URL = connectionString
-	assert.NoError(suite.t, err, "Failed to start pg container")
-	var conn *pgx.Conn
-	conn, err = libs2.NewPgConnection(ctx, config)
-	assert.NoError(suite.t, err, "Failed to create new pg connection")
-	err = libs2.RunMigration(ctx, connectionString)
-	assert.NoError(suite.t, err, "Failed to run migration")
-	suite.lr = repository.NewLogRepository(conn)
-	suite.logServer = NewLogServer(suite.lr)
+	if suite.newStore == nil {
+		// without docker or atlas only the memory and file backends are tested
+		connectionString, err := libs2.StartPgContainer(ctx)
+		if err != nil {
+			suite.T().Skipf("Failed to start pg container: %v", err)
+		}
+		config.DATABASE_URL = connectionString
+		var pool *pgxpool.Pool
+		pool, err = libs2.NewPgPool(ctx, config)
+		if err != nil {
+			suite.T().Skipf("Failed to create new pg pool: %v", err)
+		}
+		err = libs2.RunMigration(ctx, connectionString)
+		if err != nil {
+			suite.T().Skipf("Failed to run migration: %v", err)
+		}
+		suite.newStore = func(quota repository.Quota) repository.LogStore {
+			return repository.NewLogRepository(pool, config.IDEMPOTENCY_WINDOW, config.RESTORE_WINDOW, quota)
+		}
+	}
+	suite.lr = suite.newStore(repository.Quota{})
+	suite.logServer = NewLogServer(suite.lr, config.QUOTA_RETRY_AFTER, nil)
 	suite.model = ModelState{
 		CollectionData:             map[types.UniqueID][]*coordinatorpb.OperationRecord{},
 		CollectionCompactionOffset: map[types.UniqueID]int64{},
+		CollectionRestorableOffset: map[types.UniqueID]int64{},
+	}
+}
+
+// newTestRecord returns an add of a 2 dimensional vector
+func newTestRecord() *coordinatorpb.OperationRecord {
+	return &coordinatorpb.OperationRecord{
+		Id: types.NewUniqueID().String(),
+		Vector: &coordinatorpb.Vector{
+			Dimension: 2,
+			Vector:    make([]byte, 8),
+			Encoding:  coordinatorpb.ScalarEncoding_FLOAT32,
+		},
+		Operation: coordinatorpb.Operation_ADD,
 	}
 }
 
@@ -61,16 +99,18 @@ func (suite *LogServerTestSuite) TestRecordLogDb_PushLogs() {
 	collectionGen := rapid.Custom(func(t *rapid.T) types.UniqueID {
 		return collections[rapid.IntRange(0, len(collections)-1).Draw(t, "collection_id")]
 	})
-	recordGen := rapid.SliceOf(rapid.Custom(func(t *rapid.T) *coordinatorpb.OperationRecord {
-		data := rapid.SliceOf(rapid.Byte()).Draw(t, "record_data")
-		id := rapid.String().Draw(t, "record_id")
+	recordGen := rapid.SliceOfN(rapid.Custom(func(t *rapid.T) *coordinatorpb.OperationRecord {
+		dimension := rapid.IntRange(1, 8).Draw(t, "record_dimension")
+		data := rapid.SliceOfN(rapid.Byte(), dimension*4, dimension*4).Draw(t, "record_data")
+		id := rapid.StringN(1, -1, -1).Draw(t, "record_id")
 		return &coordinatorpb.OperationRecord{
 			Id: id,
 			Vector: &coordinatorpb.Vector{
-				Vector: data,
+				Dimension: int32(dimension),
+				Vector:    data,
 			},
 		}
-	}))
+	}), 1, -1)
 	rapid.Check(suite.t, func(t *rapid.T) {
 		t.Repeat(map[string]func(*rapid.T){
 			"pushLogs": func(t *rapid.T) {
@@ -96,7 +136,11 @@ func (suite *LogServerTestSuite) TestRecordLogDb_PushLogs() {
 					if err != nil {
 						t.Fatal(err)
 					}
-					compactionOffset := rapid.Int64Range(suite.model.CollectionCompactionOffset[id], int64(len(suite.model.CollectionData))).Draw(t, "new_position")
+					// skip the collections of the other tests
+					if _, ok := suite.model.CollectionData[id]; !ok {
+						continue
+					}
+					compactionOffset := rapid.Int64Range(suite.model.CollectionCompactionOffset[id], int64(len(suite.model.CollectionData[id]))).Draw(t, "new_position")
 					_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
 						CollectionId: id.String(),
 						LogOffset:    compactionOffset,
@@ -105,11 +149,14 @@ func (suite *LogServerTestSuite) TestRecordLogDb_PushLogs() {
 						t.Fatal(err)
 					}
 					suite.model.CollectionCompactionOffset[id] = compactionOffset
+					if _, ok := suite.model.CollectionRestorableOffset[id]; !ok && compactionOffset != 0 {
+						suite.model.CollectionRestorableOffset[id] = compactionOffset
+					}
 				}
 			},
 			"pullLogs": func(t *rapid.T) {
 				c := collectionGen.Draw(t, "collection")
-				startOffset := rapid.Int64Range(suite.model.CollectionCompactionOffset[c], int64(len(suite.model.CollectionData))).Draw(t, "start_offset")
+				startOffset := rapid.Int64Range(suite.model.CollectionCompactionOffset[c], int64(len(suite.model.CollectionData[c]))).Draw(t, "start_offset")
 				// If start offset is 0, we need to set it to 1 as the offset is 1 based
 				if startOffset == 0 {
 					startOffset = 1
@@ -167,14 +214,14 @@ func (suite *LogServerTestSuite) TestRecordLogDb_PushLogs() {
 			"purgeLogs": func(t *rapid.T) {
 				err := suite.lr.PurgeRecords(ctx)
 				suite.NoError(err)
-				// Verify that all record logs are purged
+				// Verify that the record logs are purged up to the restorable offset
 				for id, offset := range suite.model.CollectionCompactionOffset {
 					if offset != 0 {
 						var records []log.RecordLog
 						records, err = suite.lr.PullRecords(ctx, id.String(), 0, 1, time.Now().UnixNano())
 						suite.NoError(err)
 						if len(records) > 0 {
-							suite.Equal(offset, records[0].Offset)
+							suite.Equal(min(offset, suite.model.CollectionRestorableOffset[id]+1), records[0].Offset)
 						}
 					}
 				}
@@ -183,8 +230,766 @@ func (suite *LogServerTestSuite) TestRecordLogDb_PushLogs() {
 	})
 }
 
+func (suite *LogServerTestSuite) TestRecordLogDb_ConcurrentPushLogs() {
+	ctx := context.Background()
+	const collectionCount = 10
+	const pushersPerCollection = 4
+	const pushesPerPusher = 25
+	const recordsPerPush = 4
+
+	// Every collection is new, so the pushers of a collection also race to create it.
+	collections := make([]types.UniqueID, collectionCount)
+	for i := range collections {
+		collections[i] = types.NewUniqueID()
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, collectionCount*pushersPerCollection)
+	for _, collectionID := range collections {
+		for pusher := 0; pusher < pushersPerCollection; pusher++ {
+			wg.Add(1)
+			go func(collectionID types.UniqueID) {
+				defer wg.Done()
+				for push := 0; push < pushesPerPusher; push++ {
+					records := make([]*coordinatorpb.OperationRecord, recordsPerPush)
+					for i := range records {
+						records[i] = newTestRecord()
+					}
+					_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+						CollectionId: collectionID.String(),
+						Records:      records,
+					})
+					if err != nil {
+						errs <- err
+						return
+					}
+				}
+			}(collectionID)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		suite.NoError(err)
+	}
+
+	// No record is lost and the offsets of each collection have no gaps.
+	expectedCount := pushersPerCollection * pushesPerPusher * recordsPerPush
+	for _, collectionID := range collections {
+		response, err := suite.logServer.PullLogs(ctx, &logservicepb.PullLogsRequest{
+			CollectionId:    collectionID.String(),
+			StartFromOffset: 1,
+			BatchSize:       int32(expectedCount + 1),
+			EndTimestamp:    time.Now().UnixNano(),
+		})
+		suite.NoError(err)
+		suite.Len(response.Records, expectedCount)
+		for i, record := range response.Records {
+			suite.Equal(int64(i+1), record.LogOffset)
+		}
+	}
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_PushLogsOffsets() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+	push := func(idempotencyKey *string, count int) *logservicepb.PushLogsResponse {
+		records := make([]*coordinatorpb.OperationRecord, count)
+		for i := range records {
+			records[i] = newTestRecord()
+		}
+		res, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId:   collectionID.String(),
+			Records:        records,
+			IdempotencyKey: idempotencyKey,
+		})
+		suite.NoError(err)
+		return res
+	}
+
+	before := time.Now().UnixNano()
+	res := push(nil, 3)
+	suite.Equal(int64(1), res.FirstLogOffset)
+	suite.Equal(int64(3), res.LastLogOffset)
+	suite.GreaterOrEqual(res.Timestamp, before)
+
+	// the records are visible to a pull up to the returned timestamp
+	pulled, err := suite.logServer.PullLogs(ctx, &logservicepb.PullLogsRequest{
+		CollectionId:    collectionID.String(),
+		StartFromOffset: res.FirstLogOffset,
+		BatchSize:       10,
+		EndTimestamp:    res.Timestamp,
+	})
+	suite.NoError(err)
+	suite.Len(pulled.Records, 3)
+
+	key := "key"
+	res = push(&key, 2)
+	suite.Equal(int64(4), res.FirstLogOffset)
+	suite.Equal(int64(5), res.LastLogOffset)
+	// a retry returns the offsets and timestamp of the first push
+	replayed := push(&key, 2)
+	suite.Equal(res.FirstLogOffset, replayed.FirstLogOffset)
+	suite.Equal(res.LastLogOffset, replayed.LastLogOffset)
+	suite.Equal(res.Timestamp, replayed.Timestamp)
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_IdempotentPushLogs() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+	push := func(idempotencyKey *string, count int) int32 {
+		records := make([]*coordinatorpb.OperationRecord, count)
+		for i := range records {
+			records[i] = newTestRecord()
+		}
+		res, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId:   collectionID.String(),
+			Records:        records,
+			IdempotencyKey: idempotencyKey,
+		})
+		suite.NoError(err)
+		return res.RecordCount
+	}
+	recordCount := func() int {
+		res, err := suite.logServer.PullLogs(ctx, &logservicepb.PullLogsRequest{
+			CollectionId:    collectionID.String(),
+			StartFromOffset: 1,
+			BatchSize:       100,
+			EndTimestamp:    time.Now().UnixNano(),
+		})
+		suite.NoError(err)
+		return len(res.Records)
+	}
+
+	first := "first"
+	second := "second"
+	suite.Equal(int32(3), push(&first, 3))
+	// the retry returns the count of the first push and appends nothing
+	suite.Equal(int32(3), push(&first, 3))
+	suite.Equal(3, recordCount())
+	suite.Equal(int32(2), push(&second, 2))
+	suite.Equal(5, recordCount())
+	// pushes without a key are never deduplicated
+	suite.Equal(int32(1), push(nil, 1))
+	suite.Equal(int32(1), push(nil, 1))
+	suite.Equal(7, recordCount())
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_PushLogsQuota() {
+	ctx := context.Background()
+	lr := suite.newStore(repository.Quota{
+		MaxCollectionRecords: 5,
+		MaxTenantRecords:     8,
+	})
+	logServer := NewLogServer(lr, 3*time.Second, nil)
+	tenantID := "quota-tenant-" + types.NewUniqueID().String()
+	push := func(collectionID types.UniqueID, idempotencyKey *string, count int) error {
+		records := make([]*coordinatorpb.OperationRecord, count)
+		for i := range records {
+			records[i] = newTestRecord()
+		}
+		_, err := logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId:   collectionID.String(),
+			Records:        records,
+			IdempotencyKey: idempotencyKey,
+			TenantId:       &tenantID,
+		})
+		return err
+	}
+
+	collectionID := types.NewUniqueID()
+	key := "key"
+	suite.NoError(push(collectionID, &key, 4))
+	// over the collection quota
+	err := push(collectionID, nil, 2)
+	suite.Equal(codes.ResourceExhausted, status.Code(err))
+	var retryInfo *errdetails.RetryInfo
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retryInfo = info
+		}
+	}
+	suite.NotNil(retryInfo)
+	suite.Equal(3*time.Second, retryInfo.RetryDelay.AsDuration())
+	// a retry of an accepted push is not counted again
+	suite.NoError(push(collectionID, &key, 4))
+	suite.NoError(push(collectionID, nil, 1))
+
+	// over the tenant quota, the first collection has 5 uncompacted records
+	otherCollectionID := types.NewUniqueID()
+	suite.NoError(push(otherCollectionID, nil, 3))
+	err = push(otherCollectionID, nil, 1)
+	suite.Equal(codes.ResourceExhausted, status.Code(err))
+
+	// compaction frees the quota
+	_, err = logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+		CollectionId: collectionID.String(),
+		LogOffset:    5,
+	})
+	suite.NoError(err)
+	suite.NoError(push(otherCollectionID, nil, 1))
+	suite.NoError(push(collectionID, nil, 4))
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_ConcurrentPushLogsTenantQuota() {
+	ctx := context.Background()
+	const collectionCount = 10
+	const maxTenantRecords = 4
+	lr := suite.newStore(repository.Quota{MaxTenantRecords: maxTenantRecords})
+	logServer := NewLogServer(lr, time.Second, nil)
+	tenantID := "concurrent-quota-tenant-" + types.NewUniqueID().String()
+
+	// The pushes go to different collections of the tenant, only the tenant quota
+	// stops them.
+	var wg sync.WaitGroup
+	errs := make(chan error, collectionCount)
+	for i := 0; i < collectionCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+				CollectionId: types.NewUniqueID().String(),
+				Records:      []*coordinatorpb.OperationRecord{newTestRecord()},
+				TenantId:     &tenantID,
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	accepted := 0
+	for err := range errs {
+		if err == nil {
+			accepted++
+			continue
+		}
+		suite.Equal(codes.ResourceExhausted, status.Code(err))
+	}
+	suite.Equal(maxTenantRecords, accepted)
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_GetAllCollectionInfoToCompact() {
+	ctx := context.Background()
+	tenantID := "compaction-tenant-" + types.NewUniqueID().String()
+	push := func(collectionID types.UniqueID, count int) {
+		records := make([]*coordinatorpb.OperationRecord, count)
+		for i := range records {
+			records[i] = newTestRecord()
+		}
+		_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId: collectionID.String(),
+			Records:      records,
+			TenantId:     &tenantID,
+		})
+		suite.NoError(err)
+	}
+	getCollectionInfo := func(req *logservicepb.GetAllCollectionInfoToCompactRequest) []*logservicepb.CollectionInfo {
+		req.TenantId = &tenantID
+		res, err := suite.logServer.GetAllCollectionInfoToCompact(ctx, req)
+		suite.NoError(err)
+		return res.AllCollectionInfo
+	}
+
+	first := types.NewUniqueID()
+	second := types.NewUniqueID()
+	push(first, 5)
+	push(second, 2)
+	_, err := suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+		CollectionId: first.String(),
+		LogOffset:    2,
+	})
+	suite.NoError(err)
+
+	info := getCollectionInfo(&logservicepb.GetAllCollectionInfoToCompactRequest{})
+	suite.Len(info, 2)
+	// oldest first
+	suite.Equal(first.String(), info[0].CollectionId)
+	suite.Equal(int64(3), info[0].FirstLogOffset)
+	suite.Equal(int64(5), info[0].LatestLogOffset)
+	suite.Equal(int64(3), info[0].PendingRecordCount)
+	suite.Equal(3*int64(len(mustMarshal(suite.T(), newTestRecord()))), info[0].PendingBytes)
+	suite.Equal(tenantID, info[0].TenantId)
+	suite.Equal(second.String(), info[1].CollectionId)
+	suite.Equal(int64(2), info[1].PendingRecordCount)
+
+	minPendingRecords := int64(3)
+	info = getCollectionInfo(&logservicepb.GetAllCollectionInfoToCompactRequest{MinPendingRecords: &minPendingRecords})
+	suite.Len(info, 1)
+	suite.Equal(first.String(), info[0].CollectionId)
+
+	limit := int32(1)
+	info = getCollectionInfo(&logservicepb.GetAllCollectionInfoToCompactRequest{Limit: &limit})
+	suite.Len(info, 1)
+	suite.Equal(first.String(), info[0].CollectionId)
+
+	minAgeSeconds := int64(3600)
+	info = getCollectionInfo(&logservicepb.GetAllCollectionInfoToCompactRequest{MinAgeSeconds: &minAgeSeconds})
+	suite.Len(info, 0)
+}
+
+func mustMarshal(t *testing.T, record *coordinatorpb.OperationRecord) []byte {
+	data, err := proto.Marshal(record)
+	assert.NoError(t, err)
+	return data
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_UpdateCollectionLogOffset() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+	_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId: collectionID.String(),
+		Records:      []*coordinatorpb.OperationRecord{newTestRecord(), newTestRecord(), newTestRecord()},
+	})
+	suite.NoError(err)
+	update := func(logOffset int64, expectedLogOffset *int64, allowRegression bool) error {
+		_, err := suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+			CollectionId:      collectionID.String(),
+			LogOffset:         logOffset,
+			ExpectedLogOffset: expectedLogOffset,
+			AllowRegression:   allowRegression,
+		})
+		return err
+	}
+	expected := func(offset int64) *int64 {
+		return &offset
+	}
+
+	suite.NoError(update(2, expected(0), false))
+	// a duplicate update is accepted
+	suite.NoError(update(2, nil, false))
+	// past the last record
+	suite.Equal(codes.FailedPrecondition, status.Code(update(4, nil, false)))
+	// backwards
+	suite.Equal(codes.FailedPrecondition, status.Code(update(1, nil, false)))
+	// another compactor moved the offset first
+	err = update(3, expected(0), false)
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	suite.Contains(status.Convert(err).Message(), "compaction offset 2, enumeration offset 3")
+
+	suite.NoError(update(3, expected(2), false))
+	// restoring an older version rewinds the offset
+	suite.NoError(update(1, nil, true))
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_DeleteCollectionLog() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+	key := "key"
+	_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId:   collectionID.String(),
+		Records:        []*coordinatorpb.OperationRecord{newTestRecord(), newTestRecord()},
+		IdempotencyKey: &key,
+	})
+	suite.NoError(err)
+
+	res, err := suite.logServer.DeleteCollectionLog(ctx, &logservicepb.DeleteCollectionLogRequest{
+		CollectionId: collectionID.String(),
+	})
+	suite.NoError(err)
+	suite.Equal(int64(2), res.DeletedRecordCount)
+	records, err := suite.lr.PullRecords(ctx, collectionID.String(), 1, 10, time.Now().UnixNano())
+	suite.NoError(err)
+	suite.Len(records, 0)
+
+	// the tombstone rejects later writes, even a retry of an accepted push
+	_, err = suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId:   collectionID.String(),
+		Records:        []*coordinatorpb.OperationRecord{newTestRecord()},
+		IdempotencyKey: &key,
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+	_, err = suite.logServer.SetCollectionRetention(ctx, &logservicepb.SetCollectionRetentionRequest{
+		CollectionId: collectionID.String(),
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+
+	// deleting again does nothing
+	res, err = suite.logServer.DeleteCollectionLog(ctx, &logservicepb.DeleteCollectionLogRequest{
+		CollectionId: collectionID.String(),
+	})
+	suite.NoError(err)
+	suite.Equal(int64(0), res.DeletedRecordCount)
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_VerifyCollectionLog() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+	_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId: collectionID.String(),
+		Records:      []*coordinatorpb.OperationRecord{newTestRecord(), newTestRecord(), newTestRecord()},
+	})
+	suite.NoError(err)
+	_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+		CollectionId: collectionID.String(),
+		LogOffset:    2,
+	})
+	suite.NoError(err)
+
+	res, err := suite.logServer.VerifyCollectionLog(ctx, &logservicepb.VerifyCollectionLogRequest{
+		CollectionId: collectionID.String(),
+	})
+	suite.NoError(err)
+	suite.Equal(int64(2), res.CompactionOffset)
+	suite.Equal(int64(3), res.EnumerationOffset)
+	suite.Equal(int64(3), res.RecordCount)
+	suite.Equal(int64(0), res.UncheckedRecordCount)
+	suite.Equal(int64(1), res.FirstLogOffset)
+	suite.Equal(int64(3), res.LastLogOffset)
+	suite.Equal(int64(0), res.IssueCount)
+	suite.Empty(res.Issues)
+
+	// a collection without a log has nothing to report
+	res, err = suite.logServer.VerifyCollectionLog(ctx, &logservicepb.VerifyCollectionLogRequest{
+		CollectionId: types.NewUniqueID().String(),
+	})
+	suite.NoError(err)
+	suite.Equal(int64(0), res.RecordCount)
+	suite.Equal(int64(0), res.IssueCount)
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_PurgeRecordsBatch() {
+	ctx := context.Background()
+	pushRecords := func(collectionID types.UniqueID, count int) {
+		records := make([]*coordinatorpb.OperationRecord, count)
+		for i := range records {
+			records[i] = newTestRecord()
+		}
+		_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId: collectionID.String(),
+			Records:      records,
+		})
+		suite.NoError(err)
+	}
+	firstOffset := func(collectionID types.UniqueID) int64 {
+		records, err := suite.lr.PullRecords(ctx, collectionID.String(), 0, 1, time.Now().UnixNano())
+		suite.NoError(err)
+		suite.Len(records, 1)
+		return records[0].Offset
+	}
+
+	noRetention := types.NewUniqueID()
+	recordCountRetention := types.NewUniqueID()
+	periodRetention := types.NewUniqueID()
+	recordCount := int64(5)
+	period := int64(3600)
+	_, err := suite.logServer.SetCollectionRetention(ctx, &logservicepb.SetCollectionRetentionRequest{
+		CollectionId:         recordCountRetention.String(),
+		RetentionRecordCount: &recordCount,
+	})
+	suite.NoError(err)
+	_, err = suite.logServer.SetCollectionRetention(ctx, &logservicepb.SetCollectionRetentionRequest{
+		CollectionId:           periodRetention.String(),
+		RetentionPeriodSeconds: &period,
+	})
+	suite.NoError(err)
+	for _, collectionID := range []types.UniqueID{noRetention, recordCountRetention, periodRetention} {
+		pushRecords(collectionID, 10)
+		_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+			CollectionId: collectionID.String(),
+			LogOffset:    8,
+		})
+		suite.NoError(err)
+	}
+
+	// the collection can be restored to a version at its first compaction offset
+	restorable := types.NewUniqueID()
+	pushRecords(restorable, 10)
+	for _, logOffset := range []int64{4, 8} {
+		_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+			CollectionId: restorable.String(),
+			LogOffset:    logOffset,
+		})
+		suite.NoError(err)
+	}
+
+	for {
+		count, err := suite.lr.PurgeRecordsBatch(ctx, 3, repository.Retention{})
+		suite.NoError(err)
+		suite.LessOrEqual(count, int64(3))
+		if count == 0 {
+			break
+		}
+	}
+	suite.Equal(int64(8), firstOffset(noRetention))
+	suite.Equal(int64(6), firstOffset(recordCountRetention))
+	suite.Equal(int64(1), firstOffset(periodRetention))
+	suite.Equal(int64(5), firstOffset(restorable))
+
+	negative := int64(-1)
+	_, err = suite.logServer.SetCollectionRetention(ctx, &logservicepb.SetCollectionRetentionRequest{
+		CollectionId:         noRetention.String(),
+		RetentionRecordCount: &negative,
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+type tailLogsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *logservicepb.TailLogsResponse
+}
+
+func (s *tailLogsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *tailLogsStream) Send(res *logservicepb.TailLogsResponse) error {
+	s.responses <- res
+	return nil
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_TailLogs() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+	pushRecords := func(count int) {
+		records := make([]*coordinatorpb.OperationRecord, count)
+		for i := range records {
+			records[i] = newTestRecord()
+		}
+		_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId: collectionID.String(),
+			Records:      records,
+		})
+		suite.NoError(err)
+	}
+	// tail runs TailLogs until it has received the offsets up to lastOffset
+	tail := func(startFromOffset int64, lastOffset int64) []int64 {
+		tailCtx, cancel := context.WithCancel(ctx)
+		stream := &tailLogsStream{ctx: tailCtx, responses: make(chan *logservicepb.TailLogsResponse, 100)}
+		done := make(chan error, 1)
+		go func() {
+			done <- suite.logServer.TailLogs(&logservicepb.TailLogsRequest{
+				CollectionId:    collectionID.String(),
+				StartFromOffset: startFromOffset,
+				BatchSize:       3,
+			}, stream)
+		}()
+		var offsets []int64
+		for len(offsets) == 0 || offsets[len(offsets)-1] < lastOffset {
+			select {
+			case res := <-stream.responses:
+				suite.LessOrEqual(len(res.Records), 3)
+				for _, record := range res.Records {
+					offsets = append(offsets, record.LogOffset)
+				}
+			case <-time.After(10 * time.Second):
+				suite.FailNow("timed out waiting for records")
+			}
+		}
+		cancel()
+		suite.ErrorIs(<-done, context.Canceled)
+		return offsets
+	}
+
+	// the backlog is sent in batches, then the records pushed while tailing
+	pushRecords(5)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		pushRecords(2)
+	}()
+	suite.Equal([]int64{1, 2, 3, 4, 5, 6, 7}, tail(0, 7))
+
+	// a client that reconnects resumes after the last record it received
+	pushRecords(1)
+	suite.Equal([]int64{6, 7, 8}, tail(6, 8))
+}
+
+type exportStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*logservicepb.ExportCollectionLogResponse
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(res *logservicepb.ExportCollectionLogResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func (suite *LogServerTestSuite) TestRecordLogDb_ExportImportCollectionLog() {
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+	pushed := make([]*coordinatorpb.OperationRecord, 8)
+	for i := range pushed {
+		pushed[i] = newTestRecord()
+	}
+	for _, records := range [][]*coordinatorpb.OperationRecord{pushed[:5], pushed[5:]} {
+		_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId: collectionID.String(),
+			Records:      records,
+		})
+		suite.NoError(err)
+	}
+	exportLog := func(req *logservicepb.ExportCollectionLogRequest) []*logservicepb.LogRecord {
+		req.CollectionId = collectionID.String()
+		stream := &exportStream{ctx: ctx}
+		suite.NoError(suite.logServer.ExportCollectionLog(req, stream))
+		var records []*logservicepb.LogRecord
+		for _, res := range stream.responses {
+			records = append(records, res.Records...)
+		}
+		return records
+	}
+	offsets := func(records []*logservicepb.LogRecord) []int64 {
+		var offsets []int64
+		for _, record := range records {
+			offsets = append(offsets, record.LogOffset)
+		}
+		return offsets
+	}
+
+	exported := exportLog(&logservicepb.ExportCollectionLogRequest{})
+	if !suite.Len(exported, len(pushed)) {
+		return
+	}
+	for i, record := range exported {
+		suite.Equal(int64(i+1), record.LogOffset)
+		suite.Greater(record.Timestamp, int64(0))
+		suite.True(proto.Equal(pushed[i], record.Record))
+	}
+
+	startOffset, endOffset := int64(2), int64(4)
+	suite.Equal([]int64{2, 3, 4}, offsets(exportLog(&logservicepb.ExportCollectionLogRequest{
+		StartOffset: &startOffset,
+		EndOffset:   &endOffset,
+	})))
+	// the records of the second push, and of the first one if they have the same
+	// timestamp
+	startTimestamp := exported[5].Timestamp
+	var expected []int64
+	for _, record := range exported {
+		if record.Timestamp >= startTimestamp {
+			expected = append(expected, record.LogOffset)
+		}
+	}
+	suite.Equal(expected, offsets(exportLog(&logservicepb.ExportCollectionLogRequest{
+		StartTimestamp: &startTimestamp,
+	})))
+	endTimestamp := int64(0)
+	suite.Empty(exportLog(&logservicepb.ExportCollectionLogRequest{EndTimestamp: &endTimestamp}))
+	err := suite.logServer.ExportCollectionLog(&logservicepb.ExportCollectionLogRequest{
+		CollectionId: collectionID.String(),
+		StartOffset:  &endOffset,
+		EndOffset:    &startOffset,
+	}, &exportStream{ctx: ctx})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	// the records are imported after the records of the target collection
+	targetID := types.NewUniqueID()
+	_, err = suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId: targetID.String(),
+		Records:      []*coordinatorpb.OperationRecord{newTestRecord(), newTestRecord()},
+	})
+	suite.NoError(err)
+	importReq := &logservicepb.ImportCollectionLogRequest{
+		CollectionId:   targetID.String(),
+		IdempotencyKey: proto.String("import-0"),
+	}
+	for _, record := range exported {
+		importReq.Records = append(importReq.Records, record.Record)
+	}
+	imported, err := suite.logServer.ImportCollectionLog(ctx, importReq)
+	suite.NoError(err)
+	suite.Equal(int32(len(pushed)), imported.RecordCount)
+	suite.Equal(int64(3), imported.FirstLogOffset)
+	suite.Equal(int64(10), imported.LastLogOffset)
+	// a retried import is not appended twice
+	retried, err := suite.logServer.ImportCollectionLog(ctx, importReq)
+	suite.NoError(err)
+	suite.True(proto.Equal(imported, retried))
+
+	pulled, err := suite.logServer.PullLogs(ctx, &logservicepb.PullLogsRequest{
+		CollectionId:    targetID.String(),
+		StartFromOffset: 3,
+		BatchSize:       100,
+		EndTimestamp:    time.Now().UnixNano(),
+	})
+	suite.NoError(err)
+	if !suite.Len(pulled.Records, len(pushed)) {
+		return
+	}
+	for i, record := range pulled.Records {
+		suite.Equal(int64(i+3), record.LogOffset)
+		suite.True(proto.Equal(pushed[i], record.Record))
+	}
+}
+
+// corruptLogStore fails the checksum of a record of every collection.
+type corruptLogStore struct {
+	repository.LogStore
+	corruptOffset int64
+}
+
+func (s *corruptLogStore) PullRecords(ctx context.Context, collectionId string, offset int64, batchSize int, timestamp int64) ([]log.RecordLog, error) {
+	if offset == s.corruptOffset {
+		return nil, &repository.ChecksumError{CollectionID: collectionId, Offset: offset}
+	}
+	records, err := s.LogStore.PullRecords(ctx, collectionId, offset, batchSize, timestamp)
+	for i, record := range records {
+		if record.Offset == s.corruptOffset {
+			return records[:i], err
+		}
+	}
+	return records, err
+}
+
+func TestLogServer_ExportCorruptRecord(t *testing.T) {
+	ctx := context.Background()
+	store := &corruptLogStore{LogStore: repository.NewMemoryLogStore(time.Minute, time.Hour, repository.Quota{}), corruptOffset: 3}
+	logServer := NewLogServer(store, time.Second, nil)
+	collectionID := types.NewUniqueID().String()
+	_, err := logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId: collectionID,
+		Records:      []*coordinatorpb.OperationRecord{newTestRecord(), newTestRecord(), newTestRecord(), newTestRecord()},
+	})
+	assert.NoError(t, err)
+
+	// the records before the corrupt one are exported, then the export fails
+	stream := &exportStream{ctx: ctx}
+	err = logServer.ExportCollectionLog(&logservicepb.ExportCollectionLogRequest{CollectionId: collectionID}, stream)
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+	if assert.Len(t, stream.responses, 1) {
+		assert.Len(t, stream.responses[0].Records, 2)
+	}
+	endOffset := int64(2)
+	err = logServer.ExportCollectionLog(&logservicepb.ExportCollectionLogRequest{CollectionId: collectionID, EndOffset: &endOffset}, &exportStream{ctx: ctx})
+	assert.NoError(t, err)
+}
+
 func TestLogServerTestSuite(t *testing.T) {
 	testSuite := new(LogServerTestSuite)
 	testSuite.t = t
 	suite.Run(t, testSuite)
 }
+
+func TestLogServerMemoryTestSuite(t *testing.T) {
+	testSuite := new(LogServerTestSuite)
+	testSuite.t = t
+	testSuite.newStore = func(quota repository.Quota) repository.LogStore {
+		return repository.NewMemoryLogStore(10*time.Minute, time.Hour, quota)
+	}
+	suite.Run(t, testSuite)
+}
+
+func TestLogServerFileTestSuite(t *testing.T) {
+	testSuite := new(LogServerTestSuite)
+	testSuite.t = t
+	testSuite.newStore = func(quota repository.Quota) repository.LogStore {
+		// small segments, so that the pushes and purges go across segments
+		store, err := repository.NewFileLogStore(repository.FileLogStoreConfig{
+			Dir:         t.TempDir(),
+			FsyncPolicy: repository.FsyncNever,
+			SegmentSize: 4 << 10,
+		}, 10*time.Minute, time.Hour, quota)
+		if err != nil {
+			t.Fatalf("Failed to open the file log store: %v", err)
+		}
+		t.Cleanup(func() {
+			store.Close()
+		})
+		return store
+	}
+	suite.Run(t, testSuite)
+}
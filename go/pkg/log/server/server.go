@@ -2,79 +2,246 @@ package server
 
 import (
 	"context"
+	"errors"
 	log "github.com/chroma-core/chroma/go/database/log/db"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/chroma-core/chroma/go/pkg/log/sysdb"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"math"
+	"time"
+)
+
+const (
+	defaultTailBatchSize = 100
+	tailPollInterval     = time.Second
 )
 
 type logServer struct {
 	logservicepb.UnimplementedLogServiceServer
-	lr *repository.LogRepository
+	lr       repository.LogStore
+	notifier *collectionNotifier
+	// quotaRetryAfter is the retry delay of a push rejected by a quota
+	quotaRetryAfter time.Duration
+	// sysDB rejects pushes to unknown collections when set
+	sysDB *sysdb.CachedSysDB
 }
 
 func (s *logServer) PushLogs(ctx context.Context, req *logservicepb.PushLogsRequest) (res *logservicepb.PushLogsResponse, err error) {
 	var collectionID types.UniqueID
-	collectionID, err = types.ToUniqueID(&req.CollectionId)
+	collectionID, err = parseCollectionID(req.CollectionId)
+	if err != nil {
+		return
+	}
+	if err = validateRecords(req.Records); err != nil {
+		return
+	}
+	var collection *sysdb.Collection
+	collection, err = s.checkSysDBCollection(ctx, collectionID, req.Records)
+	if errors.Is(err, sysdb.ErrCollectionNotFound) {
+		err = status.Error(codes.NotFound, "collection "+collectionID.String()+" not found")
+		return
+	}
 	if err != nil {
-		// TODO HANDLE ERROR
 		return
 	}
+	tenantID := req.GetTenantId()
+	if tenantID == "" && collection != nil {
+		tenantID = collection.Tenant
+	}
+	var inserted repository.InsertedRecords
+	inserted, err = s.insertRecords(ctx, collectionID, tenantID, req.Records, req.GetIdempotencyKey())
+	if err != nil {
+		return
+	}
+	res = &logservicepb.PushLogsResponse{
+		RecordCount:    int32(inserted.Count),
+		FirstLogOffset: inserted.FirstOffset,
+		LastLogOffset:  inserted.LastOffset,
+		Timestamp:      inserted.Timestamp,
+	}
+	return
+}
+
+// checkSysDBCollection looks a collection up in the sysdb and validates the
+// dimension of the records against it. It returns no collection when the log
+// service runs without a sysdb, and sysdb.ErrCollectionNotFound when the sysdb
+// does not have the collection.
+func (s *logServer) checkSysDBCollection(ctx context.Context, collectionID types.UniqueID, records []*coordinatorpb.OperationRecord) (*sysdb.Collection, error) {
+	if s.sysDB == nil {
+		return nil, nil
+	}
+	collection, err := s.sysDB.GetCollection(ctx, collectionID.String())
+	if errors.Is(err, sysdb.ErrCollectionNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	if collection.Dimension != nil {
+		if err := validateRecordDimensions(records, *collection.Dimension); err != nil {
+			return nil, err
+		}
+	}
+	return collection, nil
+}
+
+// insertRecords appends records to the log of a collection and wakes up its
+// tailers. A push over a quota is ResourceExhausted.
+func (s *logServer) insertRecords(ctx context.Context, collectionID types.UniqueID, tenantID string, records []*coordinatorpb.OperationRecord, idempotencyKey string) (inserted repository.InsertedRecords, err error) {
 	var recordsContent [][]byte
-	for _, record := range req.Records {
+	for _, record := range records {
 		var data []byte
 		data, err = proto.Marshal(record)
 		if err != nil {
-			// TODO HANDLE ERROR
+			err = grpcutils.BuildInternalGrpcError(err.Error())
 			return
 		}
 		recordsContent = append(recordsContent, data)
 	}
-	var recordCount int64
-	recordCount, err = s.lr.InsertRecords(ctx, collectionID.String(), recordsContent)
+	inserted, err = s.lr.InsertRecords(ctx, collectionID.String(), tenantID, recordsContent, idempotencyKey)
 	if err != nil {
+		var quotaErr *repository.QuotaExceededError
+		if errors.As(err, &quotaErr) {
+			grpcError, buildErr := grpcutils.BuildResourceExhaustedGrpcError(quotaErr.Scope+":"+quotaErr.Subject, quotaErr.Error(), s.quotaRetryAfter)
+			if buildErr == nil {
+				err = grpcError
+			}
+		}
+		err = toGrpcError(err)
 		return
 	}
-	res = &logservicepb.PushLogsResponse{
-		RecordCount: int32(recordCount),
-	}
+	s.notifier.notify(collectionID.String())
 	return
 }
 
 func (s *logServer) PullLogs(ctx context.Context, req *logservicepb.PullLogsRequest) (res *logservicepb.PullLogsResponse, err error) {
 	var collectionID types.UniqueID
-	collectionID, err = types.ToUniqueID(&req.CollectionId)
+	collectionID, err = parseCollectionID(req.CollectionId)
 	if err != nil {
 		return
 	}
+	if err = validateBatchSize(req.BatchSize); err != nil {
+		return
+	}
 	var records []log.RecordLog
 	records, err = s.lr.PullRecords(ctx, collectionID.String(), req.StartFromOffset, int(req.BatchSize), req.EndTimestamp)
 	if err != nil {
+		err = toGrpcError(err)
+		return
+	}
+	var logRecords []*logservicepb.LogRecord
+	logRecords, err = toLogRecords(records)
+	if err != nil {
+		err = grpcutils.BuildInternalGrpcError(err.Error())
 		return
 	}
 	res = &logservicepb.PullLogsResponse{
-		Records: make([]*logservicepb.LogRecord, len(records)),
+		Records: logRecords,
 	}
+	return
+}
+
+// TailLogs sends the records of a collection from the requested offset on, then
+// keeps the stream open and sends the records pushed afterwards. Offsets never
+// change, so a client resumes after a disconnect by tailing from the offset after
+// the last record it received.
+func (s *logServer) TailLogs(req *logservicepb.TailLogsRequest, stream logservicepb.LogService_TailLogsServer) (err error) {
+	var collectionID types.UniqueID
+	collectionID, err = parseCollectionID(req.CollectionId)
+	if err != nil {
+		return
+	}
+	batchSize := defaultTailBatchSize
+	if req.BatchSize != 0 {
+		if err = validateBatchSize(req.BatchSize); err != nil {
+			return
+		}
+		batchSize = int(req.BatchSize)
+	}
+	// offsets are 1 based
+	offset := req.StartFromOffset
+	if offset < 1 {
+		offset = 1
+	}
+	ctx := stream.Context()
+	unsubscribe := s.notifier.subscribe(collectionID.String())
+	defer unsubscribe()
+	for {
+		// wait before reading so that a push between the read and the select is not missed
+		pushed := s.notifier.wait(collectionID.String())
+		var records []log.RecordLog
+		records, err = s.lr.PullRecords(ctx, collectionID.String(), offset, batchSize, math.MaxInt64)
+		if err != nil {
+			return toGrpcError(err)
+		}
+		if len(records) > 0 {
+			var logRecords []*logservicepb.LogRecord
+			logRecords, err = toLogRecords(records)
+			if err != nil {
+				return grpcutils.BuildInternalGrpcError(err.Error())
+			}
+			if err = stream.Send(&logservicepb.TailLogsResponse{Records: logRecords}); err != nil {
+				return
+			}
+			offset = records[len(records)-1].Offset + 1
+			if len(records) == batchSize {
+				// there may be more backlog to send
+				continue
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-pushed:
+		case <-time.After(tailPollInterval):
+		}
+	}
+}
 
+func toLogRecords(records []log.RecordLog) ([]*logservicepb.LogRecord, error) {
+	logRecords := make([]*logservicepb.LogRecord, len(records))
 	for index := range records {
 		record := &coordinatorpb.OperationRecord{}
-		if err = proto.Unmarshal(records[index].Record, record); err != nil {
-			return
+		if err := proto.Unmarshal(records[index].Record, record); err != nil {
+			return nil, err
 		}
-		res.Records[index] = &logservicepb.LogRecord{
+		logRecords[index] = &logservicepb.LogRecord{
 			LogOffset: records[index].Offset,
 			Record:    record,
+			Timestamp: records[index].Timestamp,
 		}
 	}
-	return
+	return logRecords, nil
 }
 
 func (s *logServer) GetAllCollectionInfoToCompact(ctx context.Context, req *logservicepb.GetAllCollectionInfoToCompactRequest) (res *logservicepb.GetAllCollectionInfoToCompactResponse, err error) {
-	var collectionToCompact []log.GetAllCollectionsToCompactRow
-	collectionToCompact, err = s.lr.GetAllCollectionInfoToCompact(ctx)
+	if req.GetMinPendingRecords() < 0 {
+		err = invalidArgument("min_pending_records", "min_pending_records must not be negative")
+		return
+	}
+	if req.GetMinAgeSeconds() < 0 {
+		err = invalidArgument("min_age_seconds", "min_age_seconds must not be negative")
+		return
+	}
+	if req.GetLimit() < 0 {
+		err = invalidArgument("limit", "limit must not be negative")
+		return
+	}
+	var collectionToCompact []log.GetCollectionsToCompactRow
+	collectionToCompact, err = s.lr.GetAllCollectionInfoToCompact(ctx, repository.CompactionFilter{
+		MinPendingRecords: req.GetMinPendingRecords(),
+		MinAge:            time.Duration(req.GetMinAgeSeconds()) * time.Second,
+		TenantID:          req.GetTenantId(),
+		Limit:             req.GetLimit(),
+	})
 	if err != nil {
+		err = toGrpcError(err)
 		return
 	}
 	res = &logservicepb.GetAllCollectionInfoToCompactResponse{
@@ -82,9 +249,13 @@ func (s *logServer) GetAllCollectionInfoToCompact(ctx context.Context, req *logs
 	}
 	for index := range collectionToCompact {
 		res.AllCollectionInfo[index] = &logservicepb.CollectionInfo{
-			CollectionId:   collectionToCompact[index].CollectionID,
-			FirstLogOffset: collectionToCompact[index].Offset,
-			FirstLogTs:     int64(collectionToCompact[index].Timestamp),
+			CollectionId:       collectionToCompact[index].CollectionID,
+			FirstLogOffset:     collectionToCompact[index].FirstOffset,
+			FirstLogTs:         collectionToCompact[index].FirstTimestamp,
+			PendingRecordCount: collectionToCompact[index].PendingRecords,
+			PendingBytes:       collectionToCompact[index].PendingBytes,
+			LatestLogOffset:    collectionToCompact[index].LatestOffset,
+			TenantId:           collectionToCompact[index].TenantID.String,
 		}
 	}
 	return
@@ -92,20 +263,211 @@ func (s *logServer) GetAllCollectionInfoToCompact(ctx context.Context, req *logs
 
 func (s *logServer) UpdateCollectionLogOffset(ctx context.Context, req *logservicepb.UpdateCollectionLogOffsetRequest) (res *logservicepb.UpdateCollectionLogOffsetResponse, err error) {
 	var collectionID types.UniqueID
-	collectionID, err = types.ToUniqueID(&req.CollectionId)
+	collectionID, err = parseCollectionID(req.CollectionId)
 	if err != nil {
 		return
 	}
-	err = s.lr.UpdateCollectionCompactionOffsetPosition(ctx, collectionID.String(), req.LogOffset)
+	if req.LogOffset < 0 {
+		err = invalidArgument("log_offset", "log_offset must not be negative")
+		return
+	}
+	err = s.lr.UpdateCollectionCompactionOffsetPosition(ctx, collectionID.String(), req.LogOffset, req.ExpectedLogOffset, req.AllowRegression)
 	if err != nil {
+		err = toGrpcError(err)
 		return
 	}
 	res = &logservicepb.UpdateCollectionLogOffsetResponse{}
 	return
 }
 
-func NewLogServer(lr *repository.LogRepository) logservicepb.LogServiceServer {
+func (s *logServer) SetCollectionRetention(ctx context.Context, req *logservicepb.SetCollectionRetentionRequest) (res *logservicepb.SetCollectionRetentionResponse, err error) {
+	var collectionID types.UniqueID
+	collectionID, err = parseCollectionID(req.CollectionId)
+	if err != nil {
+		return
+	}
+	if req.GetRetentionPeriodSeconds() < 0 {
+		err = invalidArgument("retention_period_seconds", "retention_period_seconds must not be negative")
+		return
+	}
+	if req.GetRetentionRecordCount() < 0 {
+		err = invalidArgument("retention_record_count", "retention_record_count must not be negative")
+		return
+	}
+	err = s.lr.SetCollectionRetention(ctx, collectionID.String(), req.RetentionPeriodSeconds, req.RetentionRecordCount)
+	if err != nil {
+		err = toGrpcError(err)
+		return
+	}
+	res = &logservicepb.SetCollectionRetentionResponse{}
+	return
+}
+
+// DeleteCollectionLog drops the log of a deleted collection, later pushes to the
+// collection fail with NotFound.
+func (s *logServer) DeleteCollectionLog(ctx context.Context, req *logservicepb.DeleteCollectionLogRequest) (res *logservicepb.DeleteCollectionLogResponse, err error) {
+	var collectionID types.UniqueID
+	collectionID, err = parseCollectionID(req.CollectionId)
+	if err != nil {
+		return
+	}
+	var deletedCount int64
+	deletedCount, err = s.lr.DeleteCollection(ctx, collectionID.String())
+	if err != nil {
+		err = toGrpcError(err)
+		return
+	}
+	res = &logservicepb.DeleteCollectionLogResponse{
+		DeletedRecordCount: deletedCount,
+	}
+	return
+}
+
+// VerifyCollectionLog reports the records of a collection that do not match their
+// checksum, the offsets missing after the compaction offset and the records after
+// the enumeration offset.
+func (s *logServer) VerifyCollectionLog(ctx context.Context, req *logservicepb.VerifyCollectionLogRequest) (res *logservicepb.VerifyCollectionLogResponse, err error) {
+	var collectionID types.UniqueID
+	collectionID, err = parseCollectionID(req.CollectionId)
+	if err != nil {
+		return
+	}
+	var verification repository.LogVerification
+	verification, err = s.lr.VerifyCollectionLog(ctx, collectionID.String())
+	if err != nil {
+		err = toGrpcError(err)
+		return
+	}
+	issues := make([]*logservicepb.LogIssue, len(verification.Issues))
+	for index, issue := range verification.Issues {
+		issues[index] = &logservicepb.LogIssue{
+			Kind:        toLogIssueKind(issue.Kind),
+			LogOffset:   issue.Offset,
+			Description: issue.Description,
+		}
+	}
+	res = &logservicepb.VerifyCollectionLogResponse{
+		CompactionOffset:     verification.CompactionOffset,
+		EnumerationOffset:    verification.EnumerationOffset,
+		RecordCount:          verification.RecordCount,
+		UncheckedRecordCount: verification.UncheckedRecordCount,
+		FirstLogOffset:       verification.FirstOffset,
+		LastLogOffset:        verification.LastOffset,
+		IssueCount:           verification.IssueCount,
+		Issues:               issues,
+	}
+	return
+}
+
+func toLogIssueKind(kind repository.LogIssueKind) logservicepb.LogIssue_Kind {
+	switch kind {
+	case repository.LogIssueOffsetGap:
+		return logservicepb.LogIssue_OFFSET_GAP
+	case repository.LogIssueOffsetBeyondEnumeration:
+		return logservicepb.LogIssue_OFFSET_BEYOND_ENUMERATION
+	default:
+		return logservicepb.LogIssue_CHECKSUM_MISMATCH
+	}
+}
+
+// ExportCollectionLog streams the records of a collection within the offset and
+// timestamp bounds of the request, with the offsets and timestamps they have in
+// the log.
+func (s *logServer) ExportCollectionLog(req *logservicepb.ExportCollectionLogRequest, stream logservicepb.LogService_ExportCollectionLogServer) (err error) {
+	var collectionID types.UniqueID
+	collectionID, err = parseCollectionID(req.CollectionId)
+	if err != nil {
+		return
+	}
+	if err = validateExportBounds(req); err != nil {
+		return
+	}
+	endOffset := int64(math.MaxInt64)
+	if req.EndOffset != nil {
+		endOffset = *req.EndOffset
+	}
+	endTimestamp := int64(math.MaxInt64)
+	if req.EndTimestamp != nil {
+		endTimestamp = *req.EndTimestamp
+	}
+	ctx := stream.Context()
+	for offset := req.GetStartOffset(); offset <= endOffset; {
+		var records []log.RecordLog
+		records, err = s.lr.PullRecords(ctx, collectionID.String(), offset, maxBatchSize, endTimestamp)
+		if err != nil {
+			return toGrpcError(err)
+		}
+		var exported []log.RecordLog
+		for _, record := range records {
+			if record.Offset > endOffset {
+				break
+			}
+			if record.Timestamp >= req.GetStartTimestamp() {
+				exported = append(exported, record)
+			}
+		}
+		if len(exported) > 0 {
+			var logRecords []*logservicepb.LogRecord
+			logRecords, err = toLogRecords(exported)
+			if err != nil {
+				return grpcutils.BuildInternalGrpcError(err.Error())
+			}
+			if err = stream.Send(&logservicepb.ExportCollectionLogResponse{Records: logRecords}); err != nil {
+				return
+			}
+		}
+		// a short batch may end before a corrupt record, only an empty one ends the log
+		if len(records) == 0 {
+			break
+		}
+		offset = records[len(records)-1].Offset + 1
+	}
+	return nil
+}
+
+// ImportCollectionLog appends exported records to the log of a collection with
+// the next offsets of the collection. Unlike PushLogs the collection does not need
+// to exist in the sysdb, so that a log can be replayed into a scratch collection,
+// the records of a collection the sysdb has are validated like pushed ones.
+func (s *logServer) ImportCollectionLog(ctx context.Context, req *logservicepb.ImportCollectionLogRequest) (res *logservicepb.ImportCollectionLogResponse, err error) {
+	var collectionID types.UniqueID
+	collectionID, err = parseCollectionID(req.CollectionId)
+	if err != nil {
+		return
+	}
+	if err = validateRecords(req.Records); err != nil {
+		return
+	}
+	var collection *sysdb.Collection
+	collection, err = s.checkSysDBCollection(ctx, collectionID, req.Records)
+	if errors.Is(err, sysdb.ErrCollectionNotFound) {
+		err = nil
+	} else if err != nil {
+		return
+	}
+	tenantID := ""
+	if collection != nil {
+		tenantID = collection.Tenant
+	}
+	var inserted repository.InsertedRecords
+	inserted, err = s.insertRecords(ctx, collectionID, tenantID, req.Records, req.GetIdempotencyKey())
+	if err != nil {
+		return
+	}
+	res = &logservicepb.ImportCollectionLogResponse{
+		RecordCount:    int32(inserted.Count),
+		FirstLogOffset: inserted.FirstOffset,
+		LastLogOffset:  inserted.LastOffset,
+	}
+	return
+}
+
+// NewLogServer creates the log service, sysDB is optional.
+func NewLogServer(lr repository.LogStore, quotaRetryAfter time.Duration, sysDB *sysdb.CachedSysDB) logservicepb.LogServiceServer {
 	return &logServer{
-		lr: lr,
+		lr:              lr,
+		notifier:        newCollectionNotifier(),
+		quotaRetryAfter: quotaRetryAfter,
+		sysDB:           sysDB,
 	}
 }
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"fmt"
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// maxBatchSize bounds the records returned by a pull or sent in a tail message
+const maxBatchSize = 1000
+
+func invalidArgument(fieldName string, desc string) error {
+	grpcError, err := grpcutils.BuildInvalidArgumentGrpcError(fieldName, desc)
+	if err != nil {
+		return err
+	}
+	return grpcError
+}
+
+func parseCollectionID(collectionID string) (types.UniqueID, error) {
+	id, err := types.ToUniqueID(&collectionID)
+	if err = grpcutils.BuildErrorForUUID(id, "collection", err); err != nil {
+		return types.NilUniqueID(), err
+	}
+	return id, nil
+}
+
+func validateBatchSize(batchSize int32) error {
+	if batchSize < 1 || batchSize > maxBatchSize {
+		return invalidArgument("batch_size", fmt.Sprintf("batch_size must be between 1 and %d", maxBatchSize))
+	}
+	return nil
+}
+
+// validateExportBounds checks that the bounds of an export are not negative and
+// not reversed.
+func validateExportBounds(req *logservicepb.ExportCollectionLogRequest) error {
+	if req.GetStartOffset() < 0 {
+		return invalidArgument("start_offset", "start_offset must not be negative")
+	}
+	if req.EndOffset != nil && *req.EndOffset < req.GetStartOffset() {
+		return invalidArgument("end_offset", "end_offset must not be before start_offset")
+	}
+	if req.GetStartTimestamp() < 0 {
+		return invalidArgument("start_timestamp", "start_timestamp must not be negative")
+	}
+	if req.EndTimestamp != nil && *req.EndTimestamp < req.GetStartTimestamp() {
+		return invalidArgument("end_timestamp", "end_timestamp must not be before start_timestamp")
+	}
+	return nil
+}
+
+// validateRecords checks that every record has an id, that adds and upserts carry
+// a vector, that deletes carry neither a vector nor metadata and that the vectors
+// are well formed.
+func validateRecords(records []*coordinatorpb.OperationRecord) error {
+	if len(records) == 0 {
+		return invalidArgument("records", "records must not be empty")
+	}
+	for index, record := range records {
+		fieldName := fmt.Sprintf("records[%d]", index)
+		if record.Id == "" {
+			return invalidArgument(fieldName+".id", "id must not be empty")
+		}
+		switch record.Operation {
+		case coordinatorpb.Operation_ADD, coordinatorpb.Operation_UPSERT:
+			if record.Vector == nil {
+				return invalidArgument(fieldName+".vector", record.Operation.String()+" requires a vector")
+			}
+		case coordinatorpb.Operation_UPDATE:
+		case coordinatorpb.Operation_DELETE:
+			if record.Vector != nil {
+				return invalidArgument(fieldName+".vector", "DELETE must not have a vector")
+			}
+			if record.Metadata != nil {
+				return invalidArgument(fieldName+".metadata", "DELETE must not have metadata")
+			}
+		default:
+			return invalidArgument(fieldName+".operation", "unknown operation")
+		}
+		if record.Vector != nil {
+			if err := validateVector(fieldName+".vector", record.Vector); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateRecordDimensions checks that the vectors have the dimension of their
+// collection.
+func validateRecordDimensions(records []*coordinatorpb.OperationRecord, dimension int32) error {
+	for index, record := range records {
+		if record.Vector != nil && record.Vector.Dimension != dimension {
+			return invalidArgument(fmt.Sprintf("records[%d].vector.dimension", index), fmt.Sprintf("dimension %d does not match the dimension %d of the collection", record.Vector.Dimension, dimension))
+		}
+	}
+	return nil
+}
+
+func validateVector(fieldName string, vector *coordinatorpb.Vector) error {
+	if vector.Dimension <= 0 {
+		return invalidArgument(fieldName+".dimension", "dimension must be positive")
+	}
+	var scalarSize int
+	switch vector.Encoding {
+	case coordinatorpb.ScalarEncoding_FLOAT32, coordinatorpb.ScalarEncoding_INT32:
+		scalarSize = 4
+	default:
+		return invalidArgument(fieldName+".encoding", "unknown encoding")
+	}
+	if len(vector.Vector) != int(vector.Dimension)*scalarSize {
+		return invalidArgument(fieldName+".vector", fmt.Sprintf("%d bytes do not match dimension %d", len(vector.Vector), vector.Dimension))
+	}
+	return nil
+}
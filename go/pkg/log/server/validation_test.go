@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/chroma-core/chroma/go/pkg/log/sysdb"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func fieldViolation(t *testing.T, err error) string {
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok && len(badRequest.FieldViolations) > 0 {
+			return badRequest.FieldViolations[0].Field
+		}
+	}
+	t.Fatalf("no field violation in %v", err)
+	return ""
+}
+
+func TestValidateRecords(t *testing.T) {
+	vector := &coordinatorpb.Vector{Dimension: 2, Vector: make([]byte, 8)}
+	tests := []struct {
+		name    string
+		records []*coordinatorpb.OperationRecord
+		field   string
+	}{
+		{"valid", []*coordinatorpb.OperationRecord{
+			{Id: "a", Vector: vector, Operation: coordinatorpb.Operation_ADD},
+			{Id: "b", Operation: coordinatorpb.Operation_UPDATE},
+			{Id: "c", Vector: vector, Operation: coordinatorpb.Operation_UPSERT},
+			{Id: "d", Operation: coordinatorpb.Operation_DELETE},
+		}, ""},
+		{"empty", nil, "records"},
+		{"no id", []*coordinatorpb.OperationRecord{{Vector: vector}}, "records[0].id"},
+		{"add without vector", []*coordinatorpb.OperationRecord{{Id: "a"}}, "records[0].vector"},
+		{"upsert without vector", []*coordinatorpb.OperationRecord{{Id: "a", Operation: coordinatorpb.Operation_UPSERT}}, "records[0].vector"},
+		{"delete with vector", []*coordinatorpb.OperationRecord{{Id: "a", Vector: vector, Operation: coordinatorpb.Operation_DELETE}}, "records[0].vector"},
+		{"delete with metadata", []*coordinatorpb.OperationRecord{{Id: "a", Metadata: &coordinatorpb.UpdateMetadata{}, Operation: coordinatorpb.Operation_DELETE}}, "records[0].metadata"},
+		{"unknown operation", []*coordinatorpb.OperationRecord{{Id: "a", Operation: 42}}, "records[0].operation"},
+		{"no dimension", []*coordinatorpb.OperationRecord{{Id: "a", Vector: &coordinatorpb.Vector{}}}, "records[0].vector.dimension"},
+		{"short vector", []*coordinatorpb.OperationRecord{
+			{Id: "a", Vector: vector},
+			{Id: "b", Vector: &coordinatorpb.Vector{Dimension: 3, Vector: make([]byte, 8)}},
+		}, "records[1].vector.vector"},
+		{"unknown encoding", []*coordinatorpb.OperationRecord{{Id: "a", Vector: &coordinatorpb.Vector{Dimension: 2, Vector: make([]byte, 8), Encoding: 42}}}, "records[0].vector.encoding"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateRecords(test.records)
+			if test.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, test.field, fieldViolation(t, err))
+		})
+	}
+}
+
+func TestLogServer_InvalidArguments(t *testing.T) {
+	ctx := context.Background()
+	// the requests are rejected before reaching the repository
+	s := NewLogServer(nil, 0, nil)
+	collectionID := types.NewUniqueID().String()
+
+	_, err := s.PushLogs(ctx, &logservicepb.PushLogsRequest{CollectionId: "not a uuid"})
+	assert.Equal(t, "collection_id", fieldViolation(t, err))
+	_, err = s.PushLogs(ctx, &logservicepb.PushLogsRequest{CollectionId: collectionID})
+	assert.Equal(t, "records", fieldViolation(t, err))
+
+	for _, batchSize := range []int32{0, -1, maxBatchSize + 1} {
+		_, err = s.PullLogs(ctx, &logservicepb.PullLogsRequest{CollectionId: collectionID, BatchSize: batchSize})
+		assert.Equal(t, "batch_size", fieldViolation(t, err))
+	}
+	err = s.TailLogs(&logservicepb.TailLogsRequest{CollectionId: collectionID, BatchSize: -1}, nil)
+	assert.Equal(t, "batch_size", fieldViolation(t, err))
+
+	limit := int32(-1)
+	_, err = s.GetAllCollectionInfoToCompact(ctx, &logservicepb.GetAllCollectionInfoToCompactRequest{Limit: &limit})
+	assert.Equal(t, "limit", fieldViolation(t, err))
+
+	_, err = s.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{CollectionId: collectionID, LogOffset: -1})
+	assert.Equal(t, "log_offset", fieldViolation(t, err))
+
+	negative, zero := int64(-1), int64(0)
+	for field, req := range map[string]*logservicepb.ExportCollectionLogRequest{
+		"start_offset":    {CollectionId: collectionID, StartOffset: &negative},
+		"end_offset":      {CollectionId: collectionID, EndOffset: &negative},
+		"start_timestamp": {CollectionId: collectionID, StartTimestamp: &negative},
+		"end_timestamp":   {CollectionId: collectionID, EndTimestamp: &negative, StartTimestamp: &zero},
+	} {
+		err = s.ExportCollectionLog(req, nil)
+		assert.Equal(t, field, fieldViolation(t, err))
+	}
+	_, err = s.ImportCollectionLog(ctx, &logservicepb.ImportCollectionLogRequest{CollectionId: collectionID})
+	assert.Equal(t, "records", fieldViolation(t, err))
+}
+
+func TestLogServer_PushLogsSysDB(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewSysDBClient(t)
+	// the requests are rejected before reaching the repository
+	s := NewLogServer(nil, 0, sysdb.NewCachedSysDB(client, time.Minute))
+
+	unknownID := types.NewUniqueID().String()
+	client.On("GetCollections", mock.Anything, mock.Anything).Return(&coordinatorpb.GetCollectionsResponse{
+		Status: &coordinatorpb.Status{Code: 200},
+	}, nil).Once()
+	_, err := s.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId: unknownID,
+		Records:      []*coordinatorpb.OperationRecord{{Id: "a", Operation: coordinatorpb.Operation_UPDATE}},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	collectionID := types.NewUniqueID().String()
+	dimension := int32(3)
+	client.On("GetCollections", mock.Anything, mock.Anything).Return(&coordinatorpb.GetCollectionsResponse{
+		Collections: []*coordinatorpb.Collection{{Id: collectionID, Dimension: &dimension}},
+		Status:      &coordinatorpb.Status{Code: 200},
+	}, nil).Once()
+	_, err = s.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId: collectionID,
+		Records: []*coordinatorpb.OperationRecord{
+			{Id: "a", Vector: &coordinatorpb.Vector{Dimension: 2, Vector: make([]byte, 8)}},
+		},
+	})
+	assert.Equal(t, "records[0].vector.dimension", fieldViolation(t, err))
+}
+
+func TestLogServer_ImportCollectionLogSysDB(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewSysDBClient(t)
+	s := NewLogServer(repository.NewMemoryLogStore(time.Minute, time.Hour, repository.Quota{}), 0, sysdb.NewCachedSysDB(client, time.Minute))
+	records := []*coordinatorpb.OperationRecord{
+		{Id: "a", Vector: &coordinatorpb.Vector{Dimension: 2, Vector: make([]byte, 8)}},
+	}
+
+	// a scratch collection is not in the sysdb
+	scratchID := types.NewUniqueID().String()
+	client.On("GetCollections", mock.Anything, mock.Anything).Return(&coordinatorpb.GetCollectionsResponse{
+		Status: &coordinatorpb.Status{Code: 200},
+	}, nil).Once()
+	res, err := s.ImportCollectionLog(ctx, &logservicepb.ImportCollectionLogRequest{CollectionId: scratchID, Records: records})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), res.RecordCount)
+
+	// the records of a collection the sysdb has are validated
+	collectionID := types.NewUniqueID().String()
+	dimension := int32(3)
+	client.On("GetCollections", mock.Anything, mock.Anything).Return(&coordinatorpb.GetCollectionsResponse{
+		Collections: []*coordinatorpb.Collection{{Id: collectionID, Dimension: &dimension}},
+		Status:      &coordinatorpb.Status{Code: 200},
+	}, nil).Once()
+	_, err = s.ImportCollectionLog(ctx, &logservicepb.ImportCollectionLogRequest{CollectionId: collectionID, Records: records})
+	assert.Equal(t, "records[0].vector.dimension", fieldViolation(t, err))
+}
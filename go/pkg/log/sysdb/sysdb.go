@@ -0,0 +1,107 @@
+package sysdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"sync"
+	"time"
+)
+
+// maxCacheEntries bounds the cache, expired entries are dropped when it is full
+const maxCacheEntries = 100000
+
+var ErrCollectionNotFound = errors.New("collection not found")
+
+// Collection is what the log service needs to know about a collection of the sysdb
+type Collection struct {
+	ID     string
+	Tenant string
+	// Dimension is nil until the first vector of the collection sets it
+	Dimension *int32
+}
+
+type cacheEntry struct {
+	collection *Collection
+	expiresAt  time.Time
+}
+
+// CachedSysDB looks collections up in the sysdb and caches them for a ttl, so a
+// collection deleted from the sysdb is still seen for up to a ttl. Collections
+// that are not found are not cached, a collection is known as soon as it is
+// created.
+type CachedSysDB struct {
+	client coordinatorpb.SysDBClient
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func NewCachedSysDB(client coordinatorpb.SysDBClient, ttl time.Duration) *CachedSysDB {
+	return &CachedSysDB{
+		client:  client,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// GetCollection returns the collection, or ErrCollectionNotFound when the sysdb
+// does not have it or it was deleted.
+func (c *CachedSysDB) GetCollection(ctx context.Context, collectionID string) (*Collection, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[collectionID]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		return entry.collection, nil
+	}
+
+	res, err := c.client.GetCollections(ctx, &coordinatorpb.GetCollectionsRequest{
+		Id: &collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Status != nil && res.Status.Code != 200 {
+		return nil, fmt.Errorf("sysdb failed to get collection %s: %s", collectionID, res.Status.Reason)
+	}
+	if len(res.Collections) == 0 {
+		c.mu.Lock()
+		delete(c.entries, collectionID)
+		c.mu.Unlock()
+		return nil, ErrCollectionNotFound
+	}
+	collection := &Collection{
+		ID:        res.Collections[0].Id,
+		Tenant:    res.Collections[0].Tenant,
+		Dimension: res.Collections[0].Dimension,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxCacheEntries {
+		c.evictExpired()
+	}
+	c.entries[collectionID] = cacheEntry{
+		collection: collection,
+		expiresAt:  c.now().Add(c.ttl),
+	}
+	return collection, nil
+}
+
+// evictExpired drops the expired entries, or every entry when none has expired.
+// The caller holds the lock.
+func (c *CachedSysDB) evictExpired() {
+	now := c.now()
+	for id, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+	if len(c.entries) >= maxCacheEntries {
+		c.entries = make(map[string]cacheEntry)
+	}
+}
@@ -0,0 +1,75 @@
+package sysdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func byID(collectionID string) interface{} {
+	return mock.MatchedBy(func(req *coordinatorpb.GetCollectionsRequest) bool {
+		return req.GetId() == collectionID
+	})
+}
+
+func TestCachedSysDB_GetCollection(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewSysDBClient(t)
+	cache := NewCachedSysDB(client, time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	dimension := int32(3)
+	client.On("GetCollections", mock.Anything, byID("c1")).Return(&coordinatorpb.GetCollectionsResponse{
+		Collections: []*coordinatorpb.Collection{{Id: "c1", Tenant: "t1", Dimension: &dimension}},
+		Status:      &coordinatorpb.Status{Code: 200},
+	}, nil).Twice()
+
+	collection, err := cache.GetCollection(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, &Collection{ID: "c1", Tenant: "t1", Dimension: &dimension}, collection)
+	// served from the cache
+	_, err = cache.GetCollection(ctx, "c1")
+	assert.NoError(t, err)
+	client.AssertNumberOfCalls(t, "GetCollections", 1)
+
+	// looked up again once the entry expired
+	now = now.Add(time.Minute)
+	_, err = cache.GetCollection(ctx, "c1")
+	assert.NoError(t, err)
+	client.AssertNumberOfCalls(t, "GetCollections", 2)
+}
+
+func TestCachedSysDB_CollectionNotFound(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewSysDBClient(t)
+	cache := NewCachedSysDB(client, time.Minute)
+
+	client.On("GetCollections", mock.Anything, byID("c1")).Return(&coordinatorpb.GetCollectionsResponse{
+		Status: &coordinatorpb.Status{Code: 200},
+	}, nil).Twice()
+	_, err := cache.GetCollection(ctx, "c1")
+	assert.ErrorIs(t, err, ErrCollectionNotFound)
+	// not found is not cached
+	_, err = cache.GetCollection(ctx, "c1")
+	assert.ErrorIs(t, err, ErrCollectionNotFound)
+	client.AssertNumberOfCalls(t, "GetCollections", 2)
+
+	client.On("GetCollections", mock.Anything, byID("c2")).Return(nil, status.Error(codes.Unavailable, "down")).Once()
+	_, err = cache.GetCollection(ctx, "c2")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	client.On("GetCollections", mock.Anything, byID("c3")).Return(&coordinatorpb.GetCollectionsResponse{
+		Status: &coordinatorpb.Status{Code: 500, Reason: "failed"},
+	}, nil).Once()
+	_, err = cache.GetCollection(ctx, "c3")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrCollectionNotFound)
+}
@@ -15,9 +15,11 @@ import (
 type Catalog interface {
 	ResetState(ctx context.Context) error
 	CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, error)
-	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error)
+	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, startAfterID types.UniqueID, where *model.Where) ([]*model.Collection, error)
 	DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error
 	UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error)
+	MoveCollection(ctx context.Context, moveCollection *model.MoveCollection, ts types.Timestamp) (*model.Collection, error)
+	ForkCollection(ctx context.Context, forkCollection *model.ForkCollection, ts types.Timestamp) (*model.Collection, error)
 	CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error)
 	GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error)
 	DeleteSegment(ctx context.Context, segmentID types.UniqueID) error
@@ -25,10 +27,17 @@ type Catalog interface {
 	CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase, ts types.Timestamp) (*model.Database, error)
 	GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts types.Timestamp) (*model.Database, error)
 	GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*model.Database, error)
+	DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error
+	ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error)
 	CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error)
 	GetTenants(ctx context.Context, getTenant *model.GetTenant, ts types.Timestamp) (*model.Tenant, error)
 	GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*model.Tenant, error)
+	DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error
+	ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error)
 	SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error
 	GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error)
+	GetFileReferenceCounts(ctx context.Context, filePaths []string) (map[string]int64, error)
+	GetCollectionVersions(ctx context.Context, getCollectionVersions *model.GetCollectionVersions) ([]*model.CollectionVersion, error)
+	RestoreCollectionVersion(ctx context.Context, restoreCollectionVersion *model.RestoreCollectionVersion) (*model.Collection, error)
 	FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)
 }
@@ -0,0 +1,917 @@
+package coordinator
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+type memoryTenant struct {
+	name               string
+	ts                 types.Timestamp
+	lastCompactionTime int64
+}
+
+// The catalog backed by in-memory maps. It follows the same semantics as the
+// table catalog so that the coordinator can run without Postgres, e.g. when the
+// system catalog provider is "memory" or in tests.
+//
+// Every method holds the catalog lock for its whole duration and validates its
+// input before mutating any state, which gives the same all-or-nothing behavior
+// as the transactions used by the table catalog.
+type MemoryCatalog struct {
+	mu          sync.RWMutex
+	tenants     map[string]*memoryTenant
+	databases   map[string]*model.Database
+	collections map[types.UniqueID]*model.Collection
+	segments    map[types.UniqueID]*model.Segment
+	versions    map[types.UniqueID][]*model.CollectionVersion
+	store       notification.NotificationStore
+}
+
+func NewMemoryCatalog() *MemoryCatalog {
+	mc := &MemoryCatalog{}
+	mc.reset()
+	return mc
+}
+
+func NewMemoryCatalogWithNotification(store notification.NotificationStore) *MemoryCatalog {
+	catalog := NewMemoryCatalog()
+	catalog.store = store
+	return catalog
+}
+
+var _ metastore.Catalog = (*MemoryCatalog)(nil)
+
+// reset drops all the state and recreates the default tenant and database.
+// The caller must hold the lock if the catalog is shared.
+func (mc *MemoryCatalog) reset() {
+	mc.tenants = map[string]*memoryTenant{
+		common.DefaultTenant: {
+			name:               common.DefaultTenant,
+			lastCompactionTime: time.Now().Unix(),
+		},
+	}
+	defaultDatabaseID := types.NilUniqueID().String()
+	mc.databases = map[string]*model.Database{
+		defaultDatabaseID: {
+			ID:     defaultDatabaseID,
+			Name:   common.DefaultDatabase,
+			Tenant: common.DefaultTenant,
+		},
+	}
+	mc.collections = make(map[types.UniqueID]*model.Collection)
+	mc.segments = make(map[types.UniqueID]*model.Segment)
+	mc.versions = make(map[types.UniqueID][]*model.CollectionVersion)
+}
+
+func (mc *MemoryCatalog) ResetState(ctx context.Context) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	mc.reset()
+	return nil
+}
+
+func (mc *MemoryCatalog) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase, ts types.Timestamp) (*model.Database, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if _, ok := mc.databases[createDatabase.ID]; ok {
+		log.Error("database already exists", zap.String("databaseID", createDatabase.ID))
+		return nil, common.ErrDatabaseUniqueConstraintViolation
+	}
+	if mc.findDatabase(createDatabase.Tenant, createDatabase.Name) != nil {
+		log.Error("database already exists", zap.String("tenant", createDatabase.Tenant), zap.String("database", createDatabase.Name))
+		return nil, common.ErrDatabaseUniqueConstraintViolation
+	}
+	database := &model.Database{
+		ID:     createDatabase.ID,
+		Name:   createDatabase.Name,
+		Tenant: createDatabase.Tenant,
+		Ts:     ts,
+	}
+	mc.databases[database.ID] = database
+	log.Info("database created", zap.Any("database", database))
+	return copyDatabase(database), nil
+}
+
+func (mc *MemoryCatalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts types.Timestamp) (*model.Database, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	database := mc.findDatabase(getDatabase.Tenant, getDatabase.Name)
+	if database == nil {
+		return nil, common.ErrDatabaseNotFound
+	}
+	return copyDatabase(database), nil
+}
+
+func (mc *MemoryCatalog) GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*model.Database, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	result := make([]*model.Database, 0, len(mc.databases))
+	for _, database := range mc.databases {
+		result = append(result, copyDatabase(database))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+func (mc *MemoryCatalog) ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	if _, ok := mc.tenants[listDatabases.Tenant]; !ok {
+		return nil, common.ErrTenantNotFound
+	}
+	result := make([]*model.Database, 0)
+	for _, database := range mc.databases {
+		if database.Tenant == listDatabases.Tenant && database.Name > listDatabases.StartAfter {
+			result = append(result, copyDatabase(database))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	if int(listDatabases.Limit) < len(result) {
+		result = result[:listDatabases.Limit]
+	}
+	return result, nil
+}
+
+func (mc *MemoryCatalog) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error {
+	log.Info("deleting database", zap.Any("deleteDatabase", deleteDatabase))
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	database := mc.findDatabase(deleteDatabase.Tenant, deleteDatabase.Name)
+	if database == nil {
+		return common.ErrDatabaseNotFound
+	}
+	return mc.deleteDatabasesCascade(ctx, []*model.Database{database})
+}
+
+func (mc *MemoryCatalog) CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if _, ok := mc.tenants[createTenant.Name]; ok {
+		log.Error("tenant already exists", zap.String("tenant", createTenant.Name))
+		return nil, common.ErrTenantUniqueConstraintViolation
+	}
+	mc.tenants[createTenant.Name] = &memoryTenant{
+		name:               createTenant.Name,
+		ts:                 ts,
+		lastCompactionTime: time.Now().Unix(),
+	}
+	return &model.Tenant{Name: createTenant.Name}, nil
+}
+
+func (mc *MemoryCatalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts types.Timestamp) (*model.Tenant, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	tenant, ok := mc.tenants[getTenant.Name]
+	if !ok {
+		log.Error("tenant not found", zap.String("tenant", getTenant.Name))
+		return nil, common.ErrTenantNotFound
+	}
+	return &model.Tenant{Name: tenant.name}, nil
+}
+
+func (mc *MemoryCatalog) GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*model.Tenant, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	result := make([]*model.Tenant, 0, len(mc.tenants))
+	for _, tenant := range mc.tenants {
+		result = append(result, &model.Tenant{Name: tenant.name})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+func (mc *MemoryCatalog) ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	result := make([]*model.Tenant, 0)
+	for _, tenant := range mc.tenants {
+		if tenant.name > listTenants.StartAfter {
+			result = append(result, &model.Tenant{Name: tenant.name})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	if int(listTenants.Limit) < len(result) {
+		result = result[:listTenants.Limit]
+	}
+	return result, nil
+}
+
+func (mc *MemoryCatalog) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	log.Info("deleting tenant", zap.Any("deleteTenant", deleteTenant))
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if _, ok := mc.tenants[deleteTenant.Name]; !ok {
+		return common.ErrTenantNotFound
+	}
+	var databases []*model.Database
+	for _, database := range mc.databases {
+		if database.Tenant == deleteTenant.Name {
+			databases = append(databases, database)
+		}
+	}
+	if err := mc.deleteDatabasesCascade(ctx, databases); err != nil {
+		return err
+	}
+	delete(mc.tenants, deleteTenant.Name)
+	log.Info("tenant deleted", zap.String("tenant", deleteTenant.Name))
+	return nil
+}
+
+func (mc *MemoryCatalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	tenantID := createCollection.TenantID
+	databaseName := createCollection.DatabaseName
+	if mc.findDatabase(tenantID, databaseName) == nil {
+		log.Error("database not found", zap.String("tenant", tenantID), zap.String("database", databaseName))
+		return nil, common.ErrDatabaseNotFound
+	}
+
+	if existing := mc.findCollectionByName(tenantID, databaseName, createCollection.Name); existing != nil {
+		if !createCollection.GetOrCreate {
+			return nil, common.ErrCollectionUniqueConstraintViolation
+		}
+		if createCollection.Metadata != nil && !createCollection.Metadata.Equals(existing.Metadata) {
+			existing.Metadata = copyCollectionMetadata(createCollection.Metadata)
+			existing.Ts = ts
+		}
+		return copyCollection(existing), nil
+	}
+	if _, ok := mc.collections[createCollection.ID]; ok {
+		log.Error("collection id already exists", zap.String("collectionID", createCollection.ID.String()))
+		return nil, common.ErrCollectionUniqueConstraintViolation
+	}
+
+	collection := &model.Collection{
+		ID:           createCollection.ID,
+		Name:         createCollection.Name,
+		Dimension:    copyDimension(createCollection.Dimension),
+		Metadata:     copyCollectionMetadata(createCollection.Metadata),
+		TenantID:     tenantID,
+		DatabaseName: databaseName,
+		Ts:           ts,
+		LogPosition:  0,
+	}
+	if err := mc.addNotification(ctx, collection.ID, model.NotificationTypeCreateCollection); err != nil {
+		return nil, err
+	}
+	mc.collections[collection.ID] = collection
+	log.Info("collection created", zap.Any("collection", collection))
+	return copyCollection(collection), nil
+}
+
+func (mc *MemoryCatalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, startAfterID types.UniqueID, where *model.Where) ([]*model.Collection, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	var collections []*model.Collection
+	for _, collection := range mc.collections {
+		if tenantID != "" && collection.TenantID != tenantID {
+			continue
+		}
+		if databaseName != "" && collection.DatabaseName != databaseName {
+			continue
+		}
+		if !model.FilterCollection(collection, collectionID, collectionName) {
+			continue
+		}
+		if startAfterID != types.NilUniqueID() && collection.ID.String() <= startAfterID.String() {
+			continue
+		}
+		if where != nil && !where.Matches(collection.Metadata) {
+			continue
+		}
+		collections = append(collections, collection)
+	}
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].ID.String() < collections[j].ID.String()
+	})
+
+	if offset != nil {
+		if int(*offset) >= len(collections) {
+			collections = nil
+		} else {
+			collections = collections[*offset:]
+		}
+	}
+	if limit != nil && int(*limit) < len(collections) {
+		collections = collections[:*limit]
+	}
+	if len(collections) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*model.Collection, 0, len(collections))
+	for _, collection := range collections {
+		result = append(result, copyCollection(collection))
+	}
+	return result, nil
+}
+
+func (mc *MemoryCatalog) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
+	log.Info("deleting collection", zap.Any("deleteCollection", deleteCollection))
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	collection := mc.findCollection(deleteCollection.ID, deleteCollection.TenantID, deleteCollection.DatabaseName)
+	if collection == nil {
+		return common.ErrCollectionDeleteNonExistingCollection
+	}
+	if err := mc.addNotification(ctx, collection.ID, model.NotificationTypeDeleteCollection); err != nil {
+		return err
+	}
+	delete(mc.collections, collection.ID)
+	delete(mc.versions, collection.ID)
+	log.Info("collection deleted", zap.Any("collection", collection))
+	return nil
+}
+
+func (mc *MemoryCatalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error) {
+	log.Info("updating collection", zap.String("collectionId", updateCollection.ID.String()))
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	collection := mc.findCollection(updateCollection.ID, updateCollection.TenantID, updateCollection.DatabaseName)
+	if collection == nil {
+		return nil, common.ErrCollectionNotFound
+	}
+	if updateCollection.ResetMetadata && updateCollection.Metadata != nil {
+		return nil, common.ErrInvalidMetadataUpdate
+	}
+	if updateCollection.Name != nil && *updateCollection.Name != collection.Name {
+		if mc.findCollectionByName(collection.TenantID, collection.DatabaseName, *updateCollection.Name) != nil {
+			return nil, common.ErrCollectionUniqueConstraintViolation
+		}
+		collection.Name = *updateCollection.Name
+	}
+	if updateCollection.Dimension != nil {
+		collection.Dimension = copyDimension(updateCollection.Dimension)
+	}
+
+	// Case 1: if ResetMetadata is true, then delete all metadata for the collection
+	// Case 2: if ResetMetadata is false, and the metadata is not nil - set the metadata to the value in metadata
+	// Case 3: if ResetMetadata is false and metadata is nil, then leave the metadata as is
+	if updateCollection.ResetMetadata {
+		collection.Metadata = nil
+	} else if updateCollection.Metadata != nil {
+		collection.Metadata = copyCollectionMetadata(updateCollection.Metadata)
+	}
+	collection.Ts = ts
+	log.Info("collection updated", zap.String("collectionID", collection.ID.String()))
+	return copyCollection(collection), nil
+}
+
+func (mc *MemoryCatalog) MoveCollection(ctx context.Context, moveCollection *model.MoveCollection, ts types.Timestamp) (*model.Collection, error) {
+	log.Info("moving collection", zap.String("collectionId", moveCollection.ID.String()), zap.String("database", moveCollection.DatabaseName))
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	collection := mc.findCollection(moveCollection.ID, moveCollection.TenantID, "")
+	if collection == nil {
+		return nil, common.ErrCollectionNotFound
+	}
+	if mc.findDatabase(moveCollection.TenantID, moveCollection.DatabaseName) == nil {
+		return nil, common.ErrDatabaseNotFound
+	}
+	collectionName := collection.Name
+	if moveCollection.Name != nil {
+		collectionName = *moveCollection.Name
+	}
+	if existing := mc.findCollectionByName(moveCollection.TenantID, moveCollection.DatabaseName, collectionName); existing != nil && existing.ID != collection.ID {
+		return nil, common.ErrCollectionUniqueConstraintViolation
+	}
+	if err := mc.addNotification(ctx, collection.ID, model.NotificationTypeMoveCollection); err != nil {
+		return nil, err
+	}
+
+	collection.Name = collectionName
+	collection.DatabaseName = moveCollection.DatabaseName
+	collection.Ts = ts
+	log.Info("collection moved", zap.String("collectionID", collection.ID.String()))
+	return copyCollection(collection), nil
+}
+
+func (mc *MemoryCatalog) ForkCollection(ctx context.Context, forkCollection *model.ForkCollection, ts types.Timestamp) (*model.Collection, error) {
+	log.Info("forking collection", zap.String("sourceCollectionId", forkCollection.SourceCollectionID.String()), zap.String("targetCollectionId", forkCollection.TargetCollectionID.String()))
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	source, ok := mc.collections[forkCollection.SourceCollectionID]
+	if !ok {
+		return nil, common.ErrCollectionNotFound
+	}
+	if mc.findCollectionByName(source.TenantID, source.DatabaseName, forkCollection.TargetCollectionName) != nil {
+		return nil, common.ErrCollectionUniqueConstraintViolation
+	}
+	if _, ok := mc.collections[forkCollection.TargetCollectionID]; ok {
+		return nil, common.ErrCollectionUniqueConstraintViolation
+	}
+	if err := mc.addNotification(ctx, forkCollection.TargetCollectionID, model.NotificationTypeCreateCollection); err != nil {
+		return nil, err
+	}
+
+	collection := copyCollection(source)
+	collection.ID = forkCollection.TargetCollectionID
+	collection.Name = forkCollection.TargetCollectionName
+	collection.Ts = ts
+	// the fork has a log of its own
+	collection.LogPosition = 0
+	mc.collections[collection.ID] = collection
+	for _, segment := range mc.segments {
+		if segment.CollectionID != source.ID {
+			continue
+		}
+		forked := copySegment(segment)
+		forked.ID = types.NewUniqueID()
+		forked.CollectionID = collection.ID
+		forked.Ts = ts
+		mc.segments[forked.ID] = forked
+	}
+	log.Info("collection forked", zap.String("collectionID", collection.ID.String()))
+	return copyCollection(collection), nil
+}
+
+// GetFileReferenceCounts counts the segments and the collection versions of live
+// collections using each file, like the file references of the table catalog.
+// Files that are not referenced at all are left out of the result.
+func (mc *MemoryCatalog) GetFileReferenceCounts(ctx context.Context, filePaths []string) (map[string]int64, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	requested := make(map[string]struct{}, len(filePaths))
+	for _, filePath := range filePaths {
+		requested[filePath] = struct{}{}
+	}
+	counts := map[string]int64{}
+	// count adds a reference to each requested file of a segment, once per file
+	count := func(segmentFilePaths map[string][]string) {
+		seen := map[string]struct{}{}
+		for _, paths := range segmentFilePaths {
+			for _, path := range paths {
+				if _, ok := requested[path]; !ok {
+					continue
+				}
+				if _, ok := seen[path]; ok {
+					continue
+				}
+				seen[path] = struct{}{}
+				counts[path]++
+			}
+		}
+	}
+	for _, segment := range mc.segments {
+		if _, ok := mc.collections[segment.CollectionID]; ok {
+			count(segment.FilePaths)
+		}
+	}
+	for collectionID, versions := range mc.versions {
+		if _, ok := mc.collections[collectionID]; !ok {
+			continue
+		}
+		for _, version := range versions {
+			for _, segmentCompaction := range version.FlushSegmentCompactions {
+				count(segmentCompaction.FilePaths)
+			}
+		}
+	}
+	return counts, nil
+}
+
+func (mc *MemoryCatalog) CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if _, ok := mc.segments[createSegment.ID]; ok {
+		log.Error("segment already exists", zap.String("segmentID", createSegment.ID.String()))
+		return nil, common.ErrSegmentUniqueConstraintViolation
+	}
+	segment := &model.Segment{
+		ID:           createSegment.ID,
+		Type:         createSegment.Type,
+		Scope:        createSegment.Scope,
+		CollectionID: createSegment.CollectionID,
+		Metadata:     copySegmentMetadata(createSegment.Metadata),
+		Ts:           ts,
+		FilePaths:    map[string][]string{},
+	}
+	mc.segments[segment.ID] = segment
+	log.Info("segment created", zap.Any("segment", segment))
+	return copySegment(segment), nil
+}
+
+func (mc *MemoryCatalog) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	segments := make([]*model.Segment, 0)
+	for _, segment := range mc.segments {
+		if model.FilterSegments(segment, segmentID, segmentType, scope, nil, collectionID) {
+			segments = append(segments, copySegment(segment))
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].ID.String() < segments[j].ID.String()
+	})
+	return segments, nil
+}
+
+func (mc *MemoryCatalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if _, ok := mc.segments[segmentID]; !ok {
+		return common.ErrSegmentDeleteNonExistingSegment
+	}
+	delete(mc.segments, segmentID)
+	return nil
+}
+
+func (mc *MemoryCatalog) UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment, ts types.Timestamp) (*model.Segment, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	segment, ok := mc.segments[updateSegment.ID]
+	if !ok {
+		return nil, common.ErrSegmentUpdateNonExistingSegment
+	}
+
+	// Case 1: if ResetMetadata is true, then delete all metadata for the segment
+	// Case 2: if ResetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
+	// Case 3: if ResetMetadata is false, and the metadata is not nil - set the metadata to the value in metadata
+	// Case 4: if ResetMetadata is false and metadata is nil, then leave the metadata as is
+	metadata := updateSegment.Metadata
+	if updateSegment.ResetMetadata {
+		if metadata != nil { // Case 2
+			return nil, common.ErrInvalidMetadataUpdate
+		}
+		segment.Metadata = nil // Case 1
+	} else if metadata != nil { // Case 3
+		newMetadata := copySegmentMetadata(segment.Metadata)
+		if newMetadata == nil {
+			newMetadata = model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+		}
+		for _, key := range metadata.Keys() {
+			if metadata.Get(key) == nil {
+				newMetadata.Remove(key)
+			} else {
+				newMetadata.Set(key, metadata.Get(key))
+			}
+		}
+		if newMetadata.Empty() {
+			newMetadata = nil
+		}
+		segment.Metadata = newMetadata
+	}
+	segment.Ts = ts
+	log.Debug("segment updated", zap.Any("segment", segment))
+	return copySegment(segment), nil
+}
+
+func (mc *MemoryCatalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	tenant, ok := mc.tenants[tenantID]
+	if !ok {
+		return common.ErrTenantNotFound
+	}
+	tenant.lastCompactionTime = lastCompactionTime
+	return nil
+}
+
+func (mc *MemoryCatalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	tenants := make([]*dbmodel.Tenant, 0, len(tenantIDs))
+	for _, tenantID := range tenantIDs {
+		if tenant, ok := mc.tenants[tenantID]; ok {
+			tenants = append(tenants, &dbmodel.Tenant{
+				ID:                 tenant.name,
+				LastCompactionTime: tenant.lastCompactionTime,
+			})
+		}
+	}
+	return tenants, nil
+}
+
+func (mc *MemoryCatalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	collection, ok := mc.collections[flushCollectionCompaction.ID]
+	if !ok {
+		return nil, common.ErrCollectionNotFound
+	}
+	if collection.LogPosition > flushCollectionCompaction.LogPosition {
+		return nil, common.ErrCollectionLogPositionStale
+	}
+	if collection.Version > flushCollectionCompaction.CurrentCollectionVersion {
+		return nil, common.ErrCollectionVersionStale
+	}
+	if collection.Version < flushCollectionCompaction.CurrentCollectionVersion {
+		// this should not happen, potentially a bug
+		return nil, common.ErrCollectionVersionInvalid
+	}
+	tenant, ok := mc.tenants[flushCollectionCompaction.TenantID]
+	if !ok {
+		return nil, common.ErrTenantNotFound
+	}
+
+	// register files to Segment metadata
+	for _, flushSegmentCompaction := range flushCollectionCompaction.FlushSegmentCompactions {
+		if segment, ok := mc.segments[flushSegmentCompaction.ID]; ok {
+			segment.FilePaths = copyFilePaths(flushSegmentCompaction.FilePaths)
+		}
+	}
+
+	// update collection log position and version
+	collection.LogPosition = flushCollectionCompaction.LogPosition
+	collection.Version = flushCollectionCompaction.CurrentCollectionVersion + 1
+
+	// record what the compaction produced
+	flushSegmentCompactions := make([]*model.FlushSegmentCompaction, 0, len(flushCollectionCompaction.FlushSegmentCompactions))
+	for _, flushSegmentCompaction := range flushCollectionCompaction.FlushSegmentCompactions {
+		flushSegmentCompactions = append(flushSegmentCompactions, &model.FlushSegmentCompaction{
+			ID:        flushSegmentCompaction.ID,
+			FilePaths: copyFilePaths(flushSegmentCompaction.FilePaths),
+		})
+	}
+	sort.Slice(flushSegmentCompactions, func(i, j int) bool {
+		return flushSegmentCompactions[i].ID.String() < flushSegmentCompactions[j].ID.String()
+	})
+	mc.versions[collection.ID] = append(mc.versions[collection.ID], &model.CollectionVersion{
+		CollectionID:            collection.ID,
+		Version:                 collection.Version,
+		LogPosition:             collection.LogPosition,
+		FlushSegmentCompactions: flushSegmentCompactions,
+		CreatedAt:               time.Now().Unix(),
+	})
+
+	// update tenant last compaction time
+	lastCompactionTime := time.Now().Unix()
+	tenant.lastCompactionTime = lastCompactionTime
+
+	return &model.FlushCollectionInfo{
+		ID:                       flushCollectionCompaction.ID.String(),
+		CollectionVersion:        collection.Version,
+		TenantLastCompactionTime: lastCompactionTime,
+	}, nil
+}
+
+func (mc *MemoryCatalog) GetCollectionVersions(ctx context.Context, getCollectionVersions *model.GetCollectionVersions) ([]*model.CollectionVersion, error) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	if _, ok := mc.collections[getCollectionVersions.CollectionID]; !ok {
+		return nil, common.ErrCollectionNotFound
+	}
+	var versions []*model.CollectionVersion
+	for _, version := range mc.versions[getCollectionVersions.CollectionID] {
+		if version.Version <= getCollectionVersions.StartAfterVersion {
+			continue
+		}
+		if getCollectionVersions.Limit > 0 && len(versions) >= int(getCollectionVersions.Limit) {
+			break
+		}
+		versions = append(versions, copyCollectionVersion(version))
+	}
+	return versions, nil
+}
+
+func (mc *MemoryCatalog) RestoreCollectionVersion(ctx context.Context, restoreCollectionVersion *model.RestoreCollectionVersion) (*model.Collection, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	collection, ok := mc.collections[restoreCollectionVersion.CollectionID]
+	if !ok {
+		return nil, common.ErrCollectionNotFound
+	}
+	var targetVersion *model.CollectionVersion
+	segmentFilePaths := make(map[types.UniqueID]map[string][]string)
+	for _, version := range mc.versions[collection.ID] {
+		if version.Version > restoreCollectionVersion.Version {
+			break
+		}
+		for _, flushSegmentCompaction := range version.FlushSegmentCompactions {
+			segmentFilePaths[flushSegmentCompaction.ID] = flushSegmentCompaction.FilePaths
+		}
+		targetVersion = version
+	}
+	if targetVersion == nil || targetVersion.Version != restoreCollectionVersion.Version {
+		return nil, common.ErrCollectionVersionNotFound
+	}
+
+	var flushSegmentCompactions []*model.FlushSegmentCompaction
+	for _, segment := range mc.segments {
+		if segment.CollectionID != collection.ID {
+			continue
+		}
+		segment.FilePaths = copyFilePaths(segmentFilePaths[segment.ID])
+		flushSegmentCompactions = append(flushSegmentCompactions, &model.FlushSegmentCompaction{
+			ID:        segment.ID,
+			FilePaths: copyFilePaths(segment.FilePaths),
+		})
+	}
+	sort.Slice(flushSegmentCompactions, func(i, j int) bool {
+		return flushSegmentCompactions[i].ID.String() < flushSegmentCompactions[j].ID.String()
+	})
+
+	collection.LogPosition = targetVersion.LogPosition
+	collection.Version++
+	mc.versions[collection.ID] = append(mc.versions[collection.ID], &model.CollectionVersion{
+		CollectionID:            collection.ID,
+		Version:                 collection.Version,
+		LogPosition:             collection.LogPosition,
+		FlushSegmentCompactions: flushSegmentCompactions,
+		CreatedAt:               time.Now().Unix(),
+	})
+	return copyCollection(collection), nil
+}
+
+// deleteDatabasesCascade deletes databases with their collections and segments.
+// The notifications are added before anything is deleted, so that a failing one
+// leaves every database in place. The notifications added before it are for
+// collections that still exist, the coordinator keeps their logs. The caller must
+// hold the lock.
+func (mc *MemoryCatalog) deleteDatabasesCascade(ctx context.Context, databases []*model.Database) error {
+	collectionIDs := make([][]types.UniqueID, len(databases))
+	for i, database := range databases {
+		for _, collection := range mc.collections {
+			if collection.TenantID == database.Tenant && collection.DatabaseName == database.Name {
+				collectionIDs[i] = append(collectionIDs[i], collection.ID)
+			}
+		}
+		sort.Slice(collectionIDs[i], func(j, k int) bool {
+			return collectionIDs[i][j].String() < collectionIDs[i][k].String()
+		})
+	}
+	for i := range databases {
+		for _, collectionID := range collectionIDs[i] {
+			if err := mc.addNotification(ctx, collectionID, model.NotificationTypeDeleteCollection); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, database := range databases {
+		for _, collectionID := range collectionIDs[i] {
+			for segmentID, segment := range mc.segments {
+				if segment.CollectionID == collectionID {
+					delete(mc.segments, segmentID)
+				}
+			}
+			delete(mc.collections, collectionID)
+			delete(mc.versions, collectionID)
+		}
+		delete(mc.databases, database.ID)
+		log.Info("database deleted", zap.String("databaseID", database.ID), zap.Int("collectionDeletedCount", len(collectionIDs[i])))
+	}
+	return nil
+}
+
+func (mc *MemoryCatalog) addNotification(ctx context.Context, collectionID types.UniqueID, notificationType string) error {
+	if mc.store == nil {
+		return nil
+	}
+	return mc.store.AddNotification(ctx, model.Notification{
+		CollectionID: collectionID.String(),
+		Type:         notificationType,
+		Status:       model.NotificationStatusPending,
+	})
+}
+
+func (mc *MemoryCatalog) findDatabase(tenantID string, databaseName string) *model.Database {
+	for _, database := range mc.databases {
+		if database.Tenant == tenantID && database.Name == databaseName {
+			return database
+		}
+	}
+	return nil
+}
+
+// findCollection looks up a collection by id, optionally scoped to a tenant and
+// database. Empty tenant or database names match any value, like the table catalog.
+func (mc *MemoryCatalog) findCollection(collectionID types.UniqueID, tenantID string, databaseName string) *model.Collection {
+	collection, ok := mc.collections[collectionID]
+	if !ok {
+		return nil
+	}
+	if tenantID != "" && collection.TenantID != tenantID {
+		return nil
+	}
+	if databaseName != "" && collection.DatabaseName != databaseName {
+		return nil
+	}
+	return collection
+}
+
+func (mc *MemoryCatalog) findCollectionByName(tenantID string, databaseName string, collectionName string) *model.Collection {
+	for _, collection := range mc.collections {
+		if collection.TenantID == tenantID && collection.DatabaseName == databaseName && collection.Name == collectionName {
+			return collection
+		}
+	}
+	return nil
+}
+
+func copyDatabase(database *model.Database) *model.Database {
+	result := *database
+	return &result
+}
+
+func copyDimension(dimension *int32) *int32 {
+	if dimension == nil {
+		return nil
+	}
+	result := *dimension
+	return &result
+}
+
+func copyCollection(collection *model.Collection) *model.Collection {
+	result := *collection
+	result.Dimension = copyDimension(collection.Dimension)
+	result.Metadata = copyCollectionMetadata(collection.Metadata)
+	return &result
+}
+
+func copyCollectionMetadata(metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) *model.CollectionMetadata[model.CollectionMetadataValueType] {
+	if metadata == nil {
+		return nil
+	}
+	result := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	for key, value := range metadata.Metadata {
+		result.Add(key, value)
+	}
+	return result
+}
+
+func copySegment(segment *model.Segment) *model.Segment {
+	result := *segment
+	result.Metadata = copySegmentMetadata(segment.Metadata)
+	result.FilePaths = copyFilePaths(segment.FilePaths)
+	return &result
+}
+
+func copyCollectionVersion(version *model.CollectionVersion) *model.CollectionVersion {
+	result := *version
+	result.FlushSegmentCompactions = make([]*model.FlushSegmentCompaction, 0, len(version.FlushSegmentCompactions))
+	for _, flushSegmentCompaction := range version.FlushSegmentCompactions {
+		result.FlushSegmentCompactions = append(result.FlushSegmentCompactions, &model.FlushSegmentCompaction{
+			ID:        flushSegmentCompaction.ID,
+			FilePaths: copyFilePaths(flushSegmentCompaction.FilePaths),
+		})
+	}
+	return &result
+}
+
+func copySegmentMetadata(metadata *model.SegmentMetadata[model.SegmentMetadataValueType]) *model.SegmentMetadata[model.SegmentMetadataValueType] {
+	if metadata == nil {
+		return nil
+	}
+	result := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+	for key, value := range metadata.Metadata {
+		result.Set(key, value)
+	}
+	return result
+}
+
+func copyFilePaths(filePaths map[string][]string) map[string][]string {
+	result := make(map[string][]string, len(filePaths))
+	for key, paths := range filePaths {
+		result[key] = append([]string(nil), paths...)
+	}
+	return result
+}
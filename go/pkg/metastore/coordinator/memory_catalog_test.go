@@ -0,0 +1,557 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/mocks"
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMemoryCatalog_TenantAndDatabase(t *testing.T) {
+	ctx := context.Background()
+	catalog := NewMemoryCatalog()
+
+	// the default tenant and database are created on startup
+	tenant, err := catalog.GetTenants(ctx, &model.GetTenant{Name: common.DefaultTenant}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, common.DefaultTenant, tenant.Name)
+	database, err := catalog.GetDatabases(ctx, &model.GetDatabase{Name: common.DefaultDatabase, Tenant: common.DefaultTenant}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, types.NilUniqueID().String(), database.ID)
+
+	_, err = catalog.CreateTenant(ctx, &model.CreateTenant{Name: "tenant"}, 0)
+	assert.NoError(t, err)
+	_, err = catalog.CreateTenant(ctx, &model.CreateTenant{Name: "tenant"}, 0)
+	assert.Equal(t, common.ErrTenantUniqueConstraintViolation, err)
+	_, err = catalog.GetTenants(ctx, &model.GetTenant{Name: "missing"}, 0)
+	assert.Equal(t, common.ErrTenantNotFound, err)
+
+	databaseID := types.NewUniqueID().String()
+	_, err = catalog.CreateDatabase(ctx, &model.CreateDatabase{ID: databaseID, Name: "database", Tenant: "tenant"}, 0)
+	assert.NoError(t, err)
+	_, err = catalog.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: "database", Tenant: "tenant"}, 0)
+	assert.Equal(t, common.ErrDatabaseUniqueConstraintViolation, err)
+	_, err = catalog.GetDatabases(ctx, &model.GetDatabase{Name: "database", Tenant: common.DefaultTenant}, 0)
+	assert.Equal(t, common.ErrDatabaseNotFound, err)
+
+	tenants, err := catalog.GetAllTenants(ctx, 0)
+	assert.NoError(t, err)
+	assert.Len(t, tenants, 2)
+	databases, err := catalog.GetAllDatabases(ctx, 0)
+	assert.NoError(t, err)
+	assert.Len(t, databases, 2)
+
+	err = catalog.SetTenantLastCompactionTime(ctx, "tenant", 1)
+	assert.NoError(t, err)
+	lastCompactionTimes, err := catalog.GetTenantsLastCompactionTime(ctx, []string{"tenant", "missing"})
+	assert.NoError(t, err)
+	assert.Len(t, lastCompactionTimes, 1)
+	assert.Equal(t, int64(1), lastCompactionTimes[0].LastCompactionTime)
+	assert.Equal(t, common.ErrTenantNotFound, catalog.SetTenantLastCompactionTime(ctx, "missing", 1))
+
+	// reset drops everything except the defaults
+	assert.NoError(t, catalog.ResetState(ctx))
+	tenants, err = catalog.GetAllTenants(ctx, 0)
+	assert.NoError(t, err)
+	assert.Len(t, tenants, 1)
+}
+
+func TestMemoryCatalog_Collection(t *testing.T) {
+	ctx := context.Background()
+	store := notification.NewMemoryNotificationStore()
+	catalog := NewMemoryCatalogWithNotification(store)
+
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("test_key", &model.CollectionMetadataValueStringType{Value: "test_value"})
+	createCollection := &model.CreateCollection{
+		ID:           types.MustParse("00000000-0000-0000-0000-000000000001"),
+		Name:         "test_collection",
+		Metadata:     metadata,
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+	}
+	collection, err := catalog.CreateCollection(ctx, createCollection, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, createCollection.ID, collection.ID)
+	assert.True(t, metadata.Equals(collection.Metadata))
+
+	// duplicate name in the same database
+	duplicate := *createCollection
+	duplicate.ID = types.NewUniqueID()
+	_, err = catalog.CreateCollection(ctx, &duplicate, 1)
+	assert.Equal(t, common.ErrCollectionUniqueConstraintViolation, err)
+
+	// get or create returns the existing collection
+	duplicate.GetOrCreate = true
+	duplicate.Metadata = nil
+	collection, err = catalog.CreateCollection(ctx, &duplicate, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, createCollection.ID, collection.ID)
+	assert.True(t, metadata.Equals(collection.Metadata))
+
+	// unknown database
+	missing := *createCollection
+	missing.DatabaseName = "missing"
+	_, err = catalog.CreateCollection(ctx, &missing, 1)
+	assert.Equal(t, common.ErrDatabaseNotFound, err)
+
+	// returned collections are copies
+	collection.Metadata.Add("other_key", &model.CollectionMetadataValueInt64Type{Value: 1})
+	collections, err := catalog.GetCollections(ctx, createCollection.ID, nil, defaultTenant, defaultDatabase, nil, nil, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, collections, 1)
+	assert.True(t, metadata.Equals(collections[0].Metadata))
+
+	newName := "new_name"
+	dimension := int32(128)
+	collection, err = catalog.UpdateCollection(ctx, &model.UpdateCollection{
+		ID:            createCollection.ID,
+		Name:          &newName,
+		Dimension:     &dimension,
+		ResetMetadata: true,
+	}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, newName, collection.Name)
+	assert.Equal(t, dimension, *collection.Dimension)
+	assert.Nil(t, collection.Metadata)
+
+	_, err = catalog.UpdateCollection(ctx, &model.UpdateCollection{ID: types.NewUniqueID()}, 2)
+	assert.Equal(t, common.ErrCollectionNotFound, err)
+
+	err = catalog.DeleteCollection(ctx, &model.DeleteCollection{ID: createCollection.ID, TenantID: defaultTenant, DatabaseName: defaultDatabase})
+	assert.NoError(t, err)
+	err = catalog.DeleteCollection(ctx, &model.DeleteCollection{ID: createCollection.ID, TenantID: defaultTenant, DatabaseName: defaultDatabase})
+	assert.Equal(t, common.ErrCollectionDeleteNonExistingCollection, err)
+	collections, err = catalog.GetCollections(ctx, types.NilUniqueID(), nil, defaultTenant, defaultDatabase, nil, nil, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, collections)
+
+	notifications, err := store.GetAllPendingNotifications(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, notifications[createCollection.ID.String()], 2)
+}
+
+func TestMemoryCatalog_MoveCollection(t *testing.T) {
+	ctx := context.Background()
+	store := notification.NewMemoryNotificationStore()
+	catalog := NewMemoryCatalogWithNotification(store)
+
+	_, err := catalog.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: "target", Tenant: defaultTenant}, 0)
+	assert.NoError(t, err)
+	collectionIDs := make([]types.UniqueID, 0, 2)
+	for _, target := range []struct{ name, database string }{{"docs", defaultDatabase}, {"docs", "target"}} {
+		collection, err := catalog.CreateCollection(ctx, &model.CreateCollection{
+			ID:           types.NewUniqueID(),
+			Name:         target.name,
+			TenantID:     defaultTenant,
+			DatabaseName: target.database,
+		}, 1)
+		assert.NoError(t, err)
+		collectionIDs = append(collectionIDs, collection.ID)
+	}
+	segment := &model.CreateSegment{ID: types.NewUniqueID(), Type: "test_type", Scope: "VECTOR", CollectionID: collectionIDs[0]}
+	_, err = catalog.CreateSegment(ctx, segment, 1)
+	assert.NoError(t, err)
+
+	// the name is already taken in the target database
+	_, err = catalog.MoveCollection(ctx, &model.MoveCollection{ID: collectionIDs[0], TenantID: defaultTenant, DatabaseName: "target"}, 2)
+	assert.Equal(t, common.ErrCollectionUniqueConstraintViolation, err)
+
+	newName := "docs_moved"
+	collection, err := catalog.MoveCollection(ctx, &model.MoveCollection{ID: collectionIDs[0], Name: &newName, TenantID: defaultTenant, DatabaseName: "target"}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, newName, collection.Name)
+	assert.Equal(t, "target", collection.DatabaseName)
+
+	collections, err := catalog.GetCollections(ctx, types.NilUniqueID(), nil, defaultTenant, defaultDatabase, nil, nil, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, collections)
+	collections, err = catalog.GetCollections(ctx, types.NilUniqueID(), &newName, defaultTenant, "target", nil, nil, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, collections, 1)
+	segments, err := catalog.GetSegments(ctx, types.NilUniqueID(), nil, nil, collectionIDs[0])
+	assert.NoError(t, err)
+	assert.Len(t, segments, 1)
+
+	_, err = catalog.MoveCollection(ctx, &model.MoveCollection{ID: collectionIDs[0], TenantID: defaultTenant, DatabaseName: "missing"}, 3)
+	assert.Equal(t, common.ErrDatabaseNotFound, err)
+	_, err = catalog.MoveCollection(ctx, &model.MoveCollection{ID: collectionIDs[0], TenantID: "other_tenant", DatabaseName: "target"}, 3)
+	assert.Equal(t, common.ErrCollectionNotFound, err)
+
+	notifications, err := store.GetAllPendingNotifications(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, notifications[collectionIDs[0].String()], 2)
+	assert.Equal(t, model.NotificationTypeMoveCollection, notifications[collectionIDs[0].String()][1].Type)
+}
+
+func TestMemoryCatalog_ForkCollection(t *testing.T) {
+	ctx := context.Background()
+	catalog := NewMemoryCatalog()
+
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("test_key", &model.CollectionMetadataValueStringType{Value: "test_value"})
+	sourceID := types.NewUniqueID()
+	_, err := catalog.CreateCollection(ctx, &model.CreateCollection{
+		ID:           sourceID,
+		Name:         "source",
+		Metadata:     metadata,
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+	}, 1)
+	assert.NoError(t, err)
+	segmentID := types.NewUniqueID()
+	_, err = catalog.CreateSegment(ctx, &model.CreateSegment{ID: segmentID, Type: "test_type", Scope: "VECTOR", CollectionID: sourceID}, 1)
+	assert.NoError(t, err)
+	_, err = catalog.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:       sourceID,
+		TenantID: defaultTenant,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+			{ID: segmentID, FilePaths: map[string][]string{"test_type": {"f1", "f2"}}},
+		},
+		LogPosition: 10,
+	})
+	assert.NoError(t, err)
+
+	forkID := types.NewUniqueID()
+	fork, err := catalog.ForkCollection(ctx, &model.ForkCollection{SourceCollectionID: sourceID, TargetCollectionID: forkID, TargetCollectionName: "fork"}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, forkID, fork.ID)
+	assert.Equal(t, "fork", fork.Name)
+	assert.Equal(t, int64(0), fork.LogPosition)
+	assert.Equal(t, int32(1), fork.Version)
+	assert.True(t, metadata.Equals(fork.Metadata))
+
+	segments, err := catalog.GetSegments(ctx, types.NilUniqueID(), nil, nil, forkID)
+	assert.NoError(t, err)
+	assert.Len(t, segments, 1)
+	assert.NotEqual(t, segmentID, segments[0].ID)
+	assert.Equal(t, map[string][]string{"test_type": {"f1", "f2"}}, segments[0].FilePaths)
+
+	_, err = catalog.ForkCollection(ctx, &model.ForkCollection{SourceCollectionID: sourceID, TargetCollectionID: types.NewUniqueID(), TargetCollectionName: "fork"}, 2)
+	assert.Equal(t, common.ErrCollectionUniqueConstraintViolation, err)
+	_, err = catalog.ForkCollection(ctx, &model.ForkCollection{SourceCollectionID: types.NewUniqueID(), TargetCollectionID: types.NewUniqueID(), TargetCollectionName: "other"}, 2)
+	assert.Equal(t, common.ErrCollectionNotFound, err)
+
+	// both collections reference the files until one of them moves on, the version
+	// of the source compaction holds them too
+	counts, err := catalog.GetFileReferenceCounts(ctx, []string{"f1", "f2", "f3"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"f1": 3, "f2": 3}, counts)
+
+	_, err = catalog.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       forkID,
+		TenantID:                 defaultTenant,
+		CurrentCollectionVersion: 1,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+			{ID: segments[0].ID, FilePaths: map[string][]string{"test_type": {"f1", "f3"}}},
+		},
+		LogPosition: 20,
+	})
+	assert.NoError(t, err)
+	counts, err = catalog.GetFileReferenceCounts(ctx, []string{"f1", "f2", "f3"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"f1": 4, "f2": 2, "f3": 2}, counts)
+
+	err = catalog.DeleteCollection(ctx, &model.DeleteCollection{ID: sourceID})
+	assert.NoError(t, err)
+	counts, err = catalog.GetFileReferenceCounts(ctx, []string{"f1", "f2", "f3"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"f1": 2, "f3": 2}, counts)
+}
+
+func TestMemoryCatalog_GetCollectionsLimitOffset(t *testing.T) {
+	ctx := context.Background()
+	catalog := NewMemoryCatalog()
+
+	ids := []string{
+		"00000000-0000-0000-0000-000000000003",
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000002",
+	}
+	for i, id := range ids {
+		_, err := catalog.CreateCollection(ctx, &model.CreateCollection{
+			ID:           types.MustParse(id),
+			Name:         "collection" + string(rune('a'+i)),
+			TenantID:     defaultTenant,
+			DatabaseName: defaultDatabase,
+		}, 1)
+		assert.NoError(t, err)
+	}
+
+	limit := int32(2)
+	offset := int32(1)
+	collections, err := catalog.GetCollections(ctx, types.NilUniqueID(), nil, defaultTenant, defaultDatabase, &limit, &offset, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, collections, 2)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000002", collections[0].ID.String())
+	assert.Equal(t, "00000000-0000-0000-0000-000000000003", collections[1].ID.String())
+
+	offset = 3
+	collections, err = catalog.GetCollections(ctx, types.NilUniqueID(), nil, defaultTenant, defaultDatabase, &limit, &offset, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, collections)
+}
+
+func TestMemoryCatalog_SegmentAndFlush(t *testing.T) {
+	ctx := context.Background()
+	catalog := NewMemoryCatalog()
+
+	collectionID := types.NewUniqueID()
+	_, err := catalog.CreateCollection(ctx, &model.CreateCollection{
+		ID:           collectionID,
+		Name:         "test_collection",
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+	}, 1)
+	assert.NoError(t, err)
+
+	segmentID := types.NewUniqueID()
+	createSegment := &model.CreateSegment{
+		ID:           segmentID,
+		Type:         "test_type",
+		Scope:        "VECTOR",
+		CollectionID: collectionID,
+	}
+	_, err = catalog.CreateSegment(ctx, createSegment, 1)
+	assert.NoError(t, err)
+	_, err = catalog.CreateSegment(ctx, createSegment, 1)
+	assert.Equal(t, common.ErrSegmentUniqueConstraintViolation, err)
+
+	metadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+	metadata.Set("test_key", &model.SegmentMetadataValueStringType{Value: "test_value"})
+	segment, err := catalog.UpdateSegment(ctx, &model.UpdateSegment{ID: segmentID, Metadata: metadata}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "test_value", segment.Metadata.Get("test_key").(*model.SegmentMetadataValueStringType).Value)
+	_, err = catalog.UpdateSegment(ctx, &model.UpdateSegment{ID: types.NewUniqueID()}, 2)
+	assert.Equal(t, common.ErrSegmentUpdateNonExistingSegment, err)
+
+	flush := &model.FlushCollectionCompaction{
+		ID:                       collectionID,
+		TenantID:                 defaultTenant,
+		LogPosition:              10,
+		CurrentCollectionVersion: 0,
+		FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+			{ID: segmentID, FilePaths: map[string][]string{"test_type": {"f1"}}},
+		},
+	}
+	flushInfo, err := catalog.FlushCollectionCompaction(ctx, flush)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), flushInfo.CollectionVersion)
+
+	// replaying the same flush is rejected because the version moved on
+	_, err = catalog.FlushCollectionCompaction(ctx, flush)
+	assert.Equal(t, common.ErrCollectionVersionStale, err)
+	flush.LogPosition = 5
+	flush.CurrentCollectionVersion = 1
+	_, err = catalog.FlushCollectionCompaction(ctx, flush)
+	assert.Equal(t, common.ErrCollectionLogPositionStale, err)
+
+	collections, err := catalog.GetCollections(ctx, collectionID, nil, "", "", nil, nil, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), collections[0].LogPosition)
+	assert.Equal(t, int32(1), collections[0].Version)
+
+	// the flush is kept in the version history
+	versions, err := catalog.GetCollectionVersions(ctx, &model.GetCollectionVersions{CollectionID: collectionID})
+	assert.NoError(t, err)
+	assert.Len(t, versions, 1)
+	assert.Equal(t, int32(1), versions[0].Version)
+	assert.Equal(t, int64(10), versions[0].LogPosition)
+	assert.Equal(t, []*model.FlushSegmentCompaction{{ID: segmentID, FilePaths: map[string][]string{"test_type": {"f1"}}}}, versions[0].FlushSegmentCompactions)
+	_, err = catalog.GetCollectionVersions(ctx, &model.GetCollectionVersions{CollectionID: types.NewUniqueID()})
+	assert.Equal(t, common.ErrCollectionNotFound, err)
+	segments, err := catalog.GetSegments(ctx, segmentID, nil, nil, collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"f1"}, segments[0].FilePaths["test_type"])
+
+	assert.NoError(t, catalog.DeleteSegment(ctx, segmentID))
+	assert.Equal(t, common.ErrSegmentDeleteNonExistingSegment, catalog.DeleteSegment(ctx, segmentID))
+}
+
+func TestMemoryCatalog_RestoreCollectionVersion(t *testing.T) {
+	ctx := context.Background()
+	catalog := NewMemoryCatalog()
+
+	collectionID := types.NewUniqueID()
+	_, err := catalog.CreateCollection(ctx, &model.CreateCollection{
+		ID:           collectionID,
+		Name:         "test_collection",
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+	}, 1)
+	assert.NoError(t, err)
+	vectorSegmentID := types.NewUniqueID()
+	metadataSegmentID := types.NewUniqueID()
+	for _, segmentID := range []types.UniqueID{vectorSegmentID, metadataSegmentID} {
+		_, err = catalog.CreateSegment(ctx, &model.CreateSegment{ID: segmentID, Type: "test_type", Scope: "VECTOR", CollectionID: collectionID}, 1)
+		assert.NoError(t, err)
+	}
+
+	// the second flush only touches the vector segment
+	flushes := []*model.FlushCollectionCompaction{
+		{
+			ID:                       collectionID,
+			TenantID:                 defaultTenant,
+			LogPosition:              10,
+			CurrentCollectionVersion: 0,
+			FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+				{ID: vectorSegmentID, FilePaths: map[string][]string{"test_type": {"v1"}}},
+				{ID: metadataSegmentID, FilePaths: map[string][]string{"test_type": {"m1"}}},
+			},
+		},
+		{
+			ID:                       collectionID,
+			TenantID:                 defaultTenant,
+			LogPosition:              20,
+			CurrentCollectionVersion: 1,
+			FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+				{ID: vectorSegmentID, FilePaths: map[string][]string{"test_type": {"v2"}}},
+			},
+		},
+		{
+			ID:                       collectionID,
+			TenantID:                 defaultTenant,
+			LogPosition:              30,
+			CurrentCollectionVersion: 2,
+			FlushSegmentCompactions: []*model.FlushSegmentCompaction{
+				{ID: vectorSegmentID, FilePaths: map[string][]string{"test_type": {"v3"}}},
+				{ID: metadataSegmentID, FilePaths: map[string][]string{"test_type": {"m3"}}},
+			},
+		},
+	}
+	for _, flush := range flushes {
+		_, err = catalog.FlushCollectionCompaction(ctx, flush)
+		assert.NoError(t, err)
+	}
+
+	collection, err := catalog.RestoreCollectionVersion(ctx, &model.RestoreCollectionVersion{CollectionID: collectionID, Version: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), collection.LogPosition)
+	assert.Equal(t, int32(4), collection.Version)
+
+	segments, err := catalog.GetSegments(ctx, vectorSegmentID, nil, nil, collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v2"}, segments[0].FilePaths["test_type"])
+	segments, err = catalog.GetSegments(ctx, metadataSegmentID, nil, nil, collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"m1"}, segments[0].FilePaths["test_type"])
+
+	// the restore is recorded as a new version with the files of every segment
+	versions, err := catalog.GetCollectionVersions(ctx, &model.GetCollectionVersions{CollectionID: collectionID, StartAfterVersion: 3})
+	assert.NoError(t, err)
+	assert.Len(t, versions, 1)
+	assert.Equal(t, int32(4), versions[0].Version)
+	assert.Equal(t, int64(20), versions[0].LogPosition)
+	assert.Len(t, versions[0].FlushSegmentCompactions, 2)
+
+	// a compaction started before the restore is stale
+	_, err = catalog.FlushCollectionCompaction(ctx, &model.FlushCollectionCompaction{
+		ID:                       collectionID,
+		TenantID:                 defaultTenant,
+		LogPosition:              40,
+		CurrentCollectionVersion: 3,
+	})
+	assert.Equal(t, common.ErrCollectionVersionStale, err)
+
+	_, err = catalog.RestoreCollectionVersion(ctx, &model.RestoreCollectionVersion{CollectionID: collectionID, Version: 5})
+	assert.Equal(t, common.ErrCollectionVersionNotFound, err)
+	_, err = catalog.RestoreCollectionVersion(ctx, &model.RestoreCollectionVersion{CollectionID: types.NewUniqueID(), Version: 1})
+	assert.Equal(t, common.ErrCollectionNotFound, err)
+}
+
+func TestMemoryCatalog_DeleteTenantAndDatabase(t *testing.T) {
+	ctx := context.Background()
+	store := notification.NewMemoryNotificationStore()
+	catalog := NewMemoryCatalogWithNotification(store)
+
+	_, err := catalog.CreateTenant(ctx, &model.CreateTenant{Name: "tenant"}, 0)
+	assert.NoError(t, err)
+	for _, databaseName := range []string{"database1", "database2"} {
+		_, err = catalog.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: "tenant"}, 0)
+		assert.NoError(t, err)
+	}
+	collectionIDs := []types.UniqueID{types.NewUniqueID(), types.NewUniqueID()}
+	for i, databaseName := range []string{"database1", "database2"} {
+		_, err = catalog.CreateCollection(ctx, &model.CreateCollection{
+			ID:           collectionIDs[i],
+			Name:         "test_collection",
+			TenantID:     "tenant",
+			DatabaseName: databaseName,
+		}, 1)
+		assert.NoError(t, err)
+		_, err = catalog.CreateSegment(ctx, &model.CreateSegment{ID: types.NewUniqueID(), Type: "test_type", Scope: "VECTOR", CollectionID: collectionIDs[i]}, 1)
+		assert.NoError(t, err)
+	}
+
+	err = catalog.DeleteDatabase(ctx, &model.DeleteDatabase{Name: "database1", Tenant: "tenant"})
+	assert.NoError(t, err)
+	_, err = catalog.GetDatabases(ctx, &model.GetDatabase{Name: "database1", Tenant: "tenant"}, 0)
+	assert.Equal(t, common.ErrDatabaseNotFound, err)
+	collections, err := catalog.GetCollections(ctx, collectionIDs[0], nil, "", "", nil, nil, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, collections)
+	segments, err := catalog.GetSegments(ctx, types.NilUniqueID(), nil, nil, collectionIDs[0])
+	assert.NoError(t, err)
+	assert.Empty(t, segments)
+	assert.Equal(t, common.ErrDatabaseNotFound, catalog.DeleteDatabase(ctx, &model.DeleteDatabase{Name: "database1", Tenant: "tenant"}))
+
+	err = catalog.DeleteTenant(ctx, &model.DeleteTenant{Name: "tenant"})
+	assert.NoError(t, err)
+	_, err = catalog.GetTenants(ctx, &model.GetTenant{Name: "tenant"}, 0)
+	assert.Equal(t, common.ErrTenantNotFound, err)
+	collections, err = catalog.GetCollections(ctx, types.NilUniqueID(), nil, "tenant", "", nil, nil, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, collections)
+	assert.Equal(t, common.ErrTenantNotFound, catalog.DeleteTenant(ctx, &model.DeleteTenant{Name: "tenant"}))
+
+	notifications, err := store.GetAllPendingNotifications(ctx)
+	assert.NoError(t, err)
+	for _, collectionID := range collectionIDs {
+		assert.Len(t, notifications[collectionID.String()], 2)
+		assert.Equal(t, model.NotificationTypeDeleteCollection, notifications[collectionID.String()][1].Type)
+	}
+}
+
+func TestMemoryCatalog_DeleteTenantNotificationFailure(t *testing.T) {
+	ctx := context.Background()
+	catalog := NewMemoryCatalog()
+
+	_, err := catalog.CreateTenant(ctx, &model.CreateTenant{Name: "tenant"}, 0)
+	assert.NoError(t, err)
+	for _, databaseName := range []string{"database1", "database2"} {
+		_, err = catalog.CreateDatabase(ctx, &model.CreateDatabase{ID: types.NewUniqueID().String(), Name: databaseName, Tenant: "tenant"}, 0)
+		assert.NoError(t, err)
+		_, err = catalog.CreateCollection(ctx, &model.CreateCollection{
+			ID:           types.NewUniqueID(),
+			Name:         "test_collection",
+			TenantID:     "tenant",
+			DatabaseName: databaseName,
+		}, 1)
+		assert.NoError(t, err)
+	}
+
+	// the notification of the second database fails
+	store := &mocks.NotificationStore{}
+	store.On("AddNotification", mock.Anything, mock.Anything).Return(nil).Once()
+	store.On("AddNotification", mock.Anything, mock.Anything).Return(errors.New("notification failed")).Once()
+	catalog.store = store
+	assert.Error(t, catalog.DeleteTenant(ctx, &model.DeleteTenant{Name: "tenant"}))
+	store.AssertExpectations(t)
+
+	_, err = catalog.GetTenants(ctx, &model.GetTenant{Name: "tenant"}, 0)
+	assert.NoError(t, err)
+	for _, databaseName := range []string{"database1", "database2"} {
+		_, err = catalog.GetDatabases(ctx, &model.GetDatabase{Name: databaseName, Tenant: "tenant"}, 0)
+		assert.NoError(t, err)
+	}
+	collections, err := catalog.GetCollections(ctx, types.NilUniqueID(), nil, "tenant", "", nil, nil, types.NilUniqueID(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, collections, 2)
+}
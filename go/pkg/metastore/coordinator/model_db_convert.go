@@ -1,6 +1,8 @@
 package coordinator
 
 import (
+	"sort"
+
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
@@ -166,6 +168,45 @@ func convertSegmentMetadataToDB(segmentID string, metadata *model.SegmentMetadat
 	return dbSegmentMetadataList
 }
 
+// convertFilePathsToFileReferences references the files of a segment, with the
+// collection version holding them or 0 for the files the segment uses now.
+func convertFilePathsToFileReferences(collectionID string, segmentID string, version int32, filePaths map[string][]string) []*dbmodel.FileReference {
+	fileReferences := make([]*dbmodel.FileReference, 0, len(filePaths))
+	for _, paths := range filePaths {
+		for _, path := range paths {
+			fileReferences = append(fileReferences, &dbmodel.FileReference{
+				FilePath:     path,
+				SegmentID:    segmentID,
+				Version:      version,
+				CollectionID: collectionID,
+			})
+		}
+	}
+	return fileReferences
+}
+
+func convertCollectionVersionToModel(dbCollectionVersion *dbmodel.CollectionVersion) *model.CollectionVersion {
+	segmentIDs := make([]string, 0, len(dbCollectionVersion.SegmentFilePaths))
+	for segmentID := range dbCollectionVersion.SegmentFilePaths {
+		segmentIDs = append(segmentIDs, segmentID)
+	}
+	sort.Strings(segmentIDs)
+	flushSegmentCompactions := make([]*model.FlushSegmentCompaction, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		flushSegmentCompactions = append(flushSegmentCompactions, &model.FlushSegmentCompaction{
+			ID:        types.MustParse(segmentID),
+			FilePaths: dbCollectionVersion.SegmentFilePaths[segmentID],
+		})
+	}
+	return &model.CollectionVersion{
+		CollectionID:            types.MustParse(dbCollectionVersion.CollectionID),
+		Version:                 dbCollectionVersion.Version,
+		LogPosition:             dbCollectionVersion.LogPosition,
+		FlushSegmentCompactions: flushSegmentCompactions,
+		CreatedAt:               dbCollectionVersion.CreatedAt.Unix(),
+	}
+}
+
 func convertDatabaseToModel(dbDatabase *dbmodel.Database) *model.Database {
 	return &model.Database{
 		ID:     dbDatabase.ID,
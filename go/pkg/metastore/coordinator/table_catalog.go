@@ -58,6 +58,16 @@ func (tc *Catalog) ResetState(ctx context.Context) error {
 			log.Error("error reset segment db", zap.Error(err))
 			return err
 		}
+		err = tc.metaDomain.FileReferenceDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset file reference db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionVersionDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection version db", zap.Error(err))
+			return err
+		}
 
 		err = tc.metaDomain.DatabaseDb(txCtx).DeleteAll()
 		if err != nil {
@@ -153,6 +163,97 @@ func (tc *Catalog) GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*
 	return result, nil
 }
 
+func (tc *Catalog) ListDatabases(ctx context.Context, listDatabases *model.ListDatabases) ([]*model.Database, error) {
+	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(listDatabases.Tenant)
+	if err != nil {
+		log.Error("error getting tenant", zap.Error(err))
+		return nil, err
+	}
+	if len(tenants) == 0 {
+		return nil, common.ErrTenantNotFound
+	}
+	databases, err := tc.metaDomain.DatabaseDb(ctx).ListDatabases(listDatabases.Tenant, listDatabases.StartAfter, listDatabases.Limit)
+	if err != nil {
+		log.Error("error listing databases", zap.Error(err))
+		return nil, err
+	}
+	result := make([]*model.Database, 0, len(databases))
+	for _, database := range databases {
+		result = append(result, convertDatabaseToModel(database))
+	}
+	return result, nil
+}
+
+func (tc *Catalog) DeleteDatabase(ctx context.Context, deleteDatabase *model.DeleteDatabase) error {
+	log.Info("deleting database", zap.Any("deleteDatabase", deleteDatabase))
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(deleteDatabase.Tenant, deleteDatabase.Name)
+		if err != nil {
+			log.Error("error getting database", zap.Error(err))
+			return err
+		}
+		if len(databases) == 0 {
+			return common.ErrDatabaseNotFound
+		}
+		return tc.deleteDatabaseCascade(txCtx, databases[0].ID)
+	})
+}
+
+// deleteDatabaseCascade soft deletes a database together with its collections and
+// their segments, drops the metadata of everything it deleted and enqueues a
+// delete_collection notification for each collection. It must be called within
+// a transaction.
+func (tc *Catalog) deleteDatabaseCascade(txCtx context.Context, databaseID string) error {
+	collectionIDs, err := tc.metaDomain.CollectionDb(txCtx).SoftDeleteByDatabaseID(databaseID)
+	if err != nil {
+		log.Error("error deleting collections", zap.String("databaseID", databaseID), zap.Error(err))
+		return err
+	}
+	for _, collectionID := range collectionIDs {
+		_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID)
+		if err != nil {
+			log.Error("error deleting collection metadata", zap.String("collectionID", collectionID), zap.Error(err))
+			return err
+		}
+
+		segmentIDs, err := tc.metaDomain.SegmentDb(txCtx).SoftDeleteByCollectionID(collectionID)
+		if err != nil {
+			log.Error("error deleting segments", zap.String("collectionID", collectionID), zap.Error(err))
+			return err
+		}
+		for _, segmentID := range segmentIDs {
+			err = tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(segmentID)
+			if err != nil {
+				log.Error("error deleting segment metadata", zap.String("segmentID", segmentID), zap.Error(err))
+				return err
+			}
+		}
+		err = tc.metaDomain.FileReferenceDb(txCtx).DeleteByCollectionID(collectionID)
+		if err != nil {
+			log.Error("error deleting file references", zap.String("collectionID", collectionID), zap.Error(err))
+			return err
+		}
+
+		notificationRecord := &dbmodel.Notification{
+			CollectionID: collectionID,
+			Type:         dbmodel.NotificationTypeDeleteCollection,
+			Status:       dbmodel.NotificationStatusPending,
+		}
+		err = tc.metaDomain.NotificationDb(txCtx).Insert(notificationRecord)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tc.metaDomain.DatabaseDb(txCtx).SoftDeleteByID(databaseID)
+	if err != nil {
+		log.Error("error deleting database", zap.String("databaseID", databaseID), zap.Error(err))
+		return err
+	}
+	log.Info("database deleted", zap.String("databaseID", databaseID), zap.Strings("collectionIDs", collectionIDs))
+	return nil
+}
+
 func (tc *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error) {
 	var result *model.Tenant
 
@@ -210,6 +311,53 @@ func (tc *Catalog) GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*mo
 	return result, nil
 }
 
+func (tc *Catalog) ListTenants(ctx context.Context, listTenants *model.ListTenants) ([]*model.Tenant, error) {
+	tenants, err := tc.metaDomain.TenantDb(ctx).ListTenants(listTenants.StartAfter, listTenants.Limit)
+	if err != nil {
+		log.Error("error listing tenants", zap.Error(err))
+		return nil, err
+	}
+	result := make([]*model.Tenant, 0, len(tenants))
+	for _, tenant := range tenants {
+		result = append(result, convertTenantToModel(tenant))
+	}
+	return result, nil
+}
+
+func (tc *Catalog) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	log.Info("deleting tenant", zap.Any("deleteTenant", deleteTenant))
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(deleteTenant.Name)
+		if err != nil {
+			log.Error("error getting tenant", zap.Error(err))
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabasesByTenantID(deleteTenant.Name)
+		if err != nil {
+			log.Error("error getting databases", zap.Error(err))
+			return err
+		}
+		for _, database := range databases {
+			err = tc.deleteDatabaseCascade(txCtx, database.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tc.metaDomain.TenantDb(txCtx).SoftDeleteByID(deleteTenant.Name)
+		if err != nil {
+			log.Error("error deleting tenant", zap.Error(err))
+			return err
+		}
+		log.Info("tenant deleted", zap.String("tenant", deleteTenant.Name), zap.Int("databaseDeletedCount", len(databases)))
+		return nil
+	})
+}
+
 func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, error) {
 	var result *model.Collection
 
@@ -228,7 +376,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 		}
 
 		collectionName := createCollection.Name
-		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &collectionName, tenantID, databaseName, nil, nil)
+		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &collectionName, tenantID, databaseName, nil, nil, nil, nil)
 		if err != nil {
 			log.Error("error getting collection", zap.Error(err))
 			return err
@@ -280,7 +428,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 			}
 		}
 		// get collection
-		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(createCollection.ID), nil, tenantID, databaseName, nil, nil)
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(createCollection.ID), nil, tenantID, databaseName, nil, nil, nil, nil)
 		if err != nil {
 			log.Error("error getting collection", zap.Error(err))
 			return err
@@ -306,8 +454,8 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 	return result, nil
 }
 
-func (tc *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	collectionAndMetadataList, err := tc.metaDomain.CollectionDb(ctx).GetCollections(types.FromUniqueID(collectionID), collectionName, tenantID, databaseName, limit, offset)
+func (tc *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, startAfterID types.UniqueID, where *model.Where) ([]*model.Collection, error) {
+	collectionAndMetadataList, err := tc.metaDomain.CollectionDb(ctx).GetCollections(types.FromUniqueID(collectionID), collectionName, tenantID, databaseName, limit, offset, types.FromUniqueID(startAfterID), where)
 	if err != nil {
 		return nil, err
 	}
@@ -319,7 +467,7 @@ func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model
 	log.Info("deleting collection", zap.Any("deleteCollection", deleteCollection))
 	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
 		collectionID := deleteCollection.ID
-		collectionAndMetadata, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(collectionID), nil, deleteCollection.TenantID, deleteCollection.DatabaseName, nil, nil)
+		collectionAndMetadata, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(collectionID), nil, deleteCollection.TenantID, deleteCollection.DatabaseName, nil, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -335,6 +483,14 @@ func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model
 		if err != nil {
 			return err
 		}
+		err = tc.metaDomain.FileReferenceDb(txCtx).DeleteByCollectionID(collectionID.String())
+		if err != nil {
+			return err
+		}
+		err = tc.metaDomain.CollectionVersionDb(txCtx).DeleteByCollectionID(collectionID.String())
+		if err != nil {
+			return err
+		}
 		log.Info("collection deleted", zap.Any("collection", collectionAndMetadata), zap.Int("collectionDeletedCount", collectionDeletedCount), zap.Int("collectionMetadataDeletedCount", collectionMetadataDeletedCount))
 
 		notificationRecord := &dbmodel.Notification{
@@ -351,6 +507,333 @@ func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model
 	})
 }
 
+// MoveCollection moves a collection to another database of the same tenant. Segments
+// reference their collection by id, so they move along with it.
+func (tc *Catalog) MoveCollection(ctx context.Context, moveCollection *model.MoveCollection, ts types.Timestamp) (*model.Collection, error) {
+	log.Info("moving collection", zap.String("collectionId", moveCollection.ID.String()), zap.String("database", moveCollection.DatabaseName))
+	var result *model.Collection
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(moveCollection.ID), nil, moveCollection.TenantID, "", nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collectionList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(moveCollection.TenantID, moveCollection.DatabaseName)
+		if err != nil {
+			return err
+		}
+		if len(databases) == 0 {
+			log.Error("database not found", zap.String("tenant", moveCollection.TenantID), zap.String("database", moveCollection.DatabaseName))
+			return common.ErrDatabaseNotFound
+		}
+
+		collectionName := *collectionList[0].Collection.Name
+		if moveCollection.Name != nil {
+			collectionName = *moveCollection.Name
+		}
+		// The unique index on (database_id, name) still guards against a concurrent
+		// insert; the update below then fails with a unique constraint violation.
+		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &collectionName, moveCollection.TenantID, moveCollection.DatabaseName, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(existing) != 0 && existing[0].Collection.ID != moveCollection.ID.String() {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+
+		err = tc.metaDomain.CollectionDb(txCtx).Update(&dbmodel.Collection{
+			ID:         moveCollection.ID.String(),
+			Name:       &collectionName,
+			DatabaseID: databases[0].ID,
+			Ts:         ts,
+		})
+		if err != nil {
+			return err
+		}
+
+		collectionList, err = tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(moveCollection.ID), nil, moveCollection.TenantID, moveCollection.DatabaseName, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collectionList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		result = convertCollectionToModel(collectionList)[0]
+
+		notificationRecord := &dbmodel.Notification{
+			CollectionID: moveCollection.ID.String(),
+			Type:         dbmodel.NotificationTypeMoveCollection,
+			Status:       dbmodel.NotificationStatusPending,
+		}
+		return tc.metaDomain.NotificationDb(txCtx).Insert(notificationRecord)
+	})
+	if err != nil {
+		log.Error("error moving collection", zap.Error(err))
+		return nil, err
+	}
+	log.Info("collection moved", zap.Any("collection", result))
+	return result, nil
+}
+
+// ForkCollection creates a copy of a collection in the same database. The new
+// segments point at the files of the source segments instead of copying them, and
+// the copy starts from the version of the source at log position 0, the log
+// service numbers the records of its own log from 1. Every segment using a file
+// gets a file reference, so shared files outlive the source.
+func (tc *Catalog) ForkCollection(ctx context.Context, forkCollection *model.ForkCollection, ts types.Timestamp) (*model.Collection, error) {
+	log.Info("forking collection", zap.String("sourceCollectionId", forkCollection.SourceCollectionID.String()), zap.String("targetCollectionId", forkCollection.TargetCollectionID.String()))
+	var result *model.Collection
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		sourceList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(forkCollection.SourceCollectionID), nil, "", "", nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(sourceList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		source := sourceList[0]
+
+		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &forkCollection.TargetCollectionName, source.TenantID, source.DatabaseName, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(existing) != 0 {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+		targetCollectionID := forkCollection.TargetCollectionID.String()
+		existing, err = tc.metaDomain.CollectionDb(txCtx).GetCollections(&targetCollectionID, nil, "", "", nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(existing) != 0 {
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+
+		err = tc.metaDomain.CollectionDb(txCtx).Insert(&dbmodel.Collection{
+			ID:         targetCollectionID,
+			Name:       &forkCollection.TargetCollectionName,
+			Dimension:  source.Collection.Dimension,
+			DatabaseID: source.Collection.DatabaseID,
+			Ts:         ts,
+			Version:    source.Collection.Version,
+		})
+		if err != nil {
+			log.Error("error inserting collection", zap.Error(err))
+			return err
+		}
+		sourceMetadata := convertCollectionMetadataToModel(source.CollectionMetadata)
+		dbCollectionMetadataList := convertCollectionMetadataToDB(targetCollectionID, sourceMetadata)
+		if len(dbCollectionMetadataList) != 0 {
+			err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
+			if err != nil {
+				return err
+			}
+		}
+
+		sourceSegments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(types.NilUniqueID(), nil, nil, forkCollection.SourceCollectionID)
+		if err != nil {
+			return err
+		}
+		for _, sourceSegment := range sourceSegments {
+			// segments flushed before file references existed are not tracked yet
+			err = tc.metaDomain.FileReferenceDb(txCtx).Insert(convertFilePathsToFileReferences(forkCollection.SourceCollectionID.String(), sourceSegment.Segment.ID, 0, sourceSegment.Segment.FilePaths))
+			if err != nil {
+				return err
+			}
+
+			segmentID := types.NewUniqueID().String()
+			err = tc.metaDomain.SegmentDb(txCtx).Insert(&dbmodel.Segment{
+				ID:           segmentID,
+				CollectionID: &targetCollectionID,
+				Type:         sourceSegment.Segment.Type,
+				Scope:        sourceSegment.Segment.Scope,
+				Ts:           ts,
+				FilePaths:    sourceSegment.Segment.FilePaths,
+			})
+			if err != nil {
+				log.Error("error inserting segment", zap.Error(err))
+				return err
+			}
+			sourceSegmentMetadata := convertSegmentMetadataToModel(sourceSegment.SegmentMetadata)
+			dbSegmentMetadataList := convertSegmentMetadataToDB(segmentID, sourceSegmentMetadata)
+			if len(dbSegmentMetadataList) != 0 {
+				err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
+				if err != nil {
+					return err
+				}
+			}
+			err = tc.metaDomain.FileReferenceDb(txCtx).Insert(convertFilePathsToFileReferences(targetCollectionID, segmentID, 0, sourceSegment.Segment.FilePaths))
+			if err != nil {
+				return err
+			}
+		}
+
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(forkCollection.TargetCollectionID), nil, source.TenantID, source.DatabaseName, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		result = convertCollectionToModel(collectionList)[0]
+
+		notificationRecord := &dbmodel.Notification{
+			CollectionID: targetCollectionID,
+			Type:         dbmodel.NotificationTypeCreateCollection,
+			Status:       dbmodel.NotificationStatusPending,
+		}
+		return tc.metaDomain.NotificationDb(txCtx).Insert(notificationRecord)
+	})
+	if err != nil {
+		log.Error("error forking collection", zap.Error(err))
+		return nil, err
+	}
+	log.Info("collection forked", zap.Any("collection", result))
+	return result, nil
+}
+
+func (tc *Catalog) GetFileReferenceCounts(ctx context.Context, filePaths []string) (map[string]int64, error) {
+	return tc.metaDomain.FileReferenceDb(ctx).GetReferenceCounts(filePaths)
+}
+
+func (tc *Catalog) GetCollectionVersions(ctx context.Context, getCollectionVersions *model.GetCollectionVersions) ([]*model.CollectionVersion, error) {
+	collectionList, err := tc.metaDomain.CollectionDb(ctx).GetCollections(types.FromUniqueID(getCollectionVersions.CollectionID), nil, "", "", nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(collectionList) == 0 {
+		return nil, common.ErrCollectionNotFound
+	}
+	dbCollectionVersions, err := tc.metaDomain.CollectionVersionDb(ctx).GetCollectionVersions(getCollectionVersions.CollectionID.String(), getCollectionVersions.StartAfterVersion, getCollectionVersions.Limit)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]*model.CollectionVersion, 0, len(dbCollectionVersions))
+	for _, dbCollectionVersion := range dbCollectionVersions {
+		versions = append(versions, convertCollectionVersionToModel(dbCollectionVersion))
+	}
+	return versions, nil
+}
+
+// insertCollectionVersion records a version and references its files until the
+// version is dropped with its collection, so that they are still there when the
+// collection is restored to it.
+func (tc *Catalog) insertCollectionVersion(txCtx context.Context, collectionVersion *dbmodel.CollectionVersion) error {
+	err := tc.metaDomain.CollectionVersionDb(txCtx).Insert(collectionVersion)
+	if err != nil {
+		return err
+	}
+	for segmentID, filePaths := range collectionVersion.SegmentFilePaths {
+		err = tc.metaDomain.FileReferenceDb(txCtx).Insert(convertFilePathsToFileReferences(collectionVersion.CollectionID, segmentID, collectionVersion.Version, filePaths))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreCollectionVersion points the segments of a collection back at the files of an
+// earlier version and moves the log position back with them. The restore is recorded as
+// a new version, so compactions that started before it fail as stale.
+func (tc *Catalog) RestoreCollectionVersion(ctx context.Context, restoreCollectionVersion *model.RestoreCollectionVersion) (*model.Collection, error) {
+	log.Info("restoring collection version", zap.String("collectionId", restoreCollectionVersion.CollectionID.String()), zap.Int32("version", restoreCollectionVersion.Version))
+	var result *model.Collection
+	collectionID := restoreCollectionVersion.CollectionID.String()
+
+	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(restoreCollectionVersion.CollectionID), nil, "", "", nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collectionList) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		collection := collectionList[0]
+
+		// a version only has the segments its compaction flushed, the others keep the
+		// files of an older version
+		dbCollectionVersions, err := tc.metaDomain.CollectionVersionDb(txCtx).GetCollectionVersions(collectionID, 0, 0)
+		if err != nil {
+			return err
+		}
+		var targetVersion *dbmodel.CollectionVersion
+		segmentFilePaths := make(map[string]map[string][]string)
+		for _, dbCollectionVersion := range dbCollectionVersions {
+			if dbCollectionVersion.Version > restoreCollectionVersion.Version {
+				break
+			}
+			for segmentID, filePaths := range dbCollectionVersion.SegmentFilePaths {
+				segmentFilePaths[segmentID] = filePaths
+			}
+			targetVersion = dbCollectionVersion
+		}
+		if targetVersion == nil || targetVersion.Version != restoreCollectionVersion.Version {
+			return common.ErrCollectionVersionNotFound
+		}
+
+		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(types.NilUniqueID(), nil, nil, restoreCollectionVersion.CollectionID)
+		if err != nil {
+			return err
+		}
+		flushSegmentCompactions := make([]*model.FlushSegmentCompaction, 0, len(segments))
+		restoredSegmentFilePaths := make(map[string]map[string][]string, len(segments))
+		for _, segment := range segments {
+			filePaths, ok := segmentFilePaths[segment.Segment.ID]
+			if !ok {
+				filePaths = map[string][]string{}
+			}
+			flushSegmentCompactions = append(flushSegmentCompactions, &model.FlushSegmentCompaction{
+				ID:        types.MustParse(segment.Segment.ID),
+				FilePaths: filePaths,
+			})
+			restoredSegmentFilePaths[segment.Segment.ID] = filePaths
+		}
+		err = tc.metaDomain.SegmentDb(txCtx).RegisterFilePaths(flushSegmentCompactions)
+		if err != nil {
+			return err
+		}
+		for _, flushSegmentCompaction := range flushSegmentCompactions {
+			segmentID := flushSegmentCompaction.ID.String()
+			err = tc.metaDomain.FileReferenceDb(txCtx).DeleteBySegmentID(segmentID)
+			if err != nil {
+				return err
+			}
+			err = tc.metaDomain.FileReferenceDb(txCtx).Insert(convertFilePathsToFileReferences(collectionID, segmentID, 0, flushSegmentCompaction.FilePaths))
+			if err != nil {
+				return err
+			}
+		}
+
+		version, err := tc.metaDomain.CollectionDb(txCtx).RestoreLogPositionAndVersion(collectionID, targetVersion.LogPosition, collection.Collection.Version)
+		if err != nil {
+			return err
+		}
+		err = tc.insertCollectionVersion(txCtx, &dbmodel.CollectionVersion{
+			CollectionID:     collectionID,
+			Version:          version,
+			LogPosition:      targetVersion.LogPosition,
+			SegmentFilePaths: restoredSegmentFilePaths,
+		})
+		if err != nil {
+			return err
+		}
+
+		collectionList, err = tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(restoreCollectionVersion.CollectionID), nil, "", "", nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		result = convertCollectionToModel(collectionList)[0]
+		return nil
+	})
+	if err != nil {
+		log.Error("error restoring collection version", zap.Error(err))
+		return nil, err
+	}
+	log.Info("collection version restored", zap.Any("collection", result))
+	return result, nil
+}
+
 func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error) {
 	log.Info("updating collection", zap.String("collectionId", updateCollection.ID.String()))
 	var result *model.Collection
@@ -399,7 +882,7 @@ func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model
 		}
 		databaseName := updateCollection.DatabaseName
 		tenantID := updateCollection.TenantID
-		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(updateCollection.ID), nil, tenantID, databaseName, nil, nil)
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(updateCollection.ID), nil, tenantID, databaseName, nil, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -509,6 +992,11 @@ func (tc *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID)
 			log.Error("error deleting segment metadata", zap.Error(err))
 			return err
 		}
+		err = tc.metaDomain.FileReferenceDb(txCtx).DeleteBySegmentID(segmentID.String())
+		if err != nil {
+			log.Error("error deleting file references", zap.Error(err))
+			return err
+		}
 		return nil
 	})
 }
@@ -622,6 +1110,18 @@ func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectio
 		if err != nil {
 			return err
 		}
+		// the flushed file paths replace the files referenced by each segment
+		for _, flushSegmentCompaction := range flushCollectionCompaction.FlushSegmentCompactions {
+			segmentID := flushSegmentCompaction.ID.String()
+			err = tc.metaDomain.FileReferenceDb(txCtx).DeleteBySegmentID(segmentID)
+			if err != nil {
+				return err
+			}
+			err = tc.metaDomain.FileReferenceDb(txCtx).Insert(convertFilePathsToFileReferences(flushCollectionCompaction.ID.String(), segmentID, 0, flushSegmentCompaction.FilePaths))
+			if err != nil {
+				return err
+			}
+		}
 
 		// update collection log position and version
 		collectionVersion, err := tc.metaDomain.CollectionDb(txCtx).UpdateLogPositionAndVersion(flushCollectionCompaction.ID.String(), flushCollectionCompaction.LogPosition, flushCollectionCompaction.CurrentCollectionVersion)
@@ -630,6 +1130,21 @@ func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectio
 		}
 		flushCollectionInfo.CollectionVersion = collectionVersion
 
+		// record what the compaction produced, the rows above only keep the latest state
+		segmentFilePaths := make(map[string]map[string][]string, len(flushCollectionCompaction.FlushSegmentCompactions))
+		for _, flushSegmentCompaction := range flushCollectionCompaction.FlushSegmentCompactions {
+			segmentFilePaths[flushSegmentCompaction.ID.String()] = flushSegmentCompaction.FilePaths
+		}
+		err = tc.insertCollectionVersion(txCtx, &dbmodel.CollectionVersion{
+			CollectionID:     flushCollectionCompaction.ID.String(),
+			Version:          collectionVersion,
+			LogPosition:      flushCollectionCompaction.LogPosition,
+			SegmentFilePaths: segmentFilePaths,
+		})
+		if err != nil {
+			return err
+		}
+
 		// update tenant last compaction time
 		// TODO: add a system configuration to disable
 		// since this might cause resource contention if one tenant has a lot of collection compactions at the same time
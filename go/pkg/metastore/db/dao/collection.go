@@ -3,9 +3,11 @@ package dao
 import (
 	"database/sql"
 	"errors"
+	"strconv"
 	"strings"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm/clause"
 
@@ -26,47 +28,67 @@ func (s *collectionDb) DeleteAll() error {
 	return s.db.Where("1 = 1").Delete(&dbmodel.Collection{}).Error
 }
 
-func (s *collectionDb) GetCollections(id *string, name *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*dbmodel.CollectionAndMetadata, error) {
+func (s *collectionDb) GetCollections(id *string, name *string, tenantID string, databaseName string, limit *int32, offset *int32, startAfterID *string, where *model.Where) ([]*dbmodel.CollectionAndMetadata, error) {
 	var getCollectionInput strings.Builder
 	getCollectionInput.WriteString("GetCollections input: ")
 
 	var collections []*dbmodel.CollectionAndMetadata
 
-	query := s.db.Table("collections").
-		Select("collections.id, collections.log_position, collections.version, collections.name, collections.dimension, collections.database_id, collections.created_at, databases.name, databases.tenant_id, collection_metadata.key, collection_metadata.str_value, collection_metadata.int_value, collection_metadata.float_value").
-		Joins("LEFT JOIN collection_metadata ON collections.id = collection_metadata.collection_id").
+	// Select the page of collections first and join the metadata afterwards, so that
+	// limit and offset apply to collections rather than to joined metadata rows.
+	collectionQuery := s.db.Table("collections").
+		Select("collections.id, collections.log_position, collections.version, collections.name, collections.dimension, collections.database_id, collections.created_at, databases.name AS database_name, databases.tenant_id AS database_tenant_id").
 		Joins("INNER JOIN databases ON collections.database_id = databases.id").
+		Where("collections.is_deleted = ?", false).
 		Order("collections.id asc")
 	if limit != nil {
-		query = query.Limit(int(*limit))
-		getCollectionInput.WriteString("limit: " + string(*limit) + ", ")
+		collectionQuery = collectionQuery.Limit(int(*limit))
+		getCollectionInput.WriteString("limit: " + strconv.Itoa(int(*limit)) + ", ")
 	}
 
 	if offset != nil {
-		query = query.Offset(int(*offset))
-		getCollectionInput.WriteString("offset: " + string(*offset) + ", ")
+		collectionQuery = collectionQuery.Offset(int(*offset))
+		getCollectionInput.WriteString("offset: " + strconv.Itoa(int(*offset)) + ", ")
+	}
+
+	if startAfterID != nil {
+		collectionQuery = collectionQuery.Where("collections.id > ?", *startAfterID)
+		getCollectionInput.WriteString("startAfterID: " + *startAfterID + ", ")
 	}
 
 	if databaseName != "" {
-		query = query.Where("databases.name = ?", databaseName)
+		collectionQuery = collectionQuery.Where("databases.name = ?", databaseName)
 		getCollectionInput.WriteString("databases.name: " + databaseName + ", ")
 	}
 
 	if tenantID != "" {
-		query = query.Where("databases.tenant_id = ?", tenantID)
+		collectionQuery = collectionQuery.Where("databases.tenant_id = ?", tenantID)
 		getCollectionInput.WriteString("databases.tenant_id: " + tenantID + ", ")
 	}
 
 	if id != nil {
-		query = query.Where("collections.id = ?", *id)
+		collectionQuery = collectionQuery.Where("collections.id = ?", *id)
 		getCollectionInput.WriteString("collections.id: " + *id + ", ")
 	}
 	if name != nil {
-		query = query.Where("collections.name = ?", *name)
+		collectionQuery = collectionQuery.Where("collections.name = ?", *name)
 		getCollectionInput.WriteString("collections.name: " + *name + ", ")
 	}
+	if where != nil {
+		condition, args, err := buildMetadataFilter(where)
+		if err != nil {
+			return nil, err
+		}
+		collectionQuery = collectionQuery.Where(condition, args...)
+		getCollectionInput.WriteString("where: " + condition + ", ")
+	}
 	log.Info(getCollectionInput.String())
 
+	query := s.db.Table("(?) AS collections", collectionQuery).
+		Select("collections.id, collections.log_position, collections.version, collections.name, collections.dimension, collections.database_id, collections.created_at, collections.database_name, collections.database_tenant_id, collection_metadata.key, collection_metadata.str_value, collection_metadata.int_value, collection_metadata.float_value").
+		Joins("LEFT JOIN collection_metadata ON collections.id = collection_metadata.collection_id").
+		Order("collections.id asc")
+
 	rows, err := query.Rows()
 	if err != nil {
 		return nil, err
@@ -162,12 +184,114 @@ func (s *collectionDb) GetCollections(id *string, name *string, tenantID string,
 	return collections, nil
 }
 
+// buildMetadataFilter translates a where clause into a SQL condition on collections.id.
+// Every comparison becomes a subquery on collection_metadata against the value column
+// matching the type of the compared values.
+func buildMetadataFilter(where *model.Where) (string, []interface{}, error) {
+	if where.Comparison != nil {
+		return buildMetadataComparison(where.Comparison)
+	}
+	if len(where.Children) == 0 {
+		return "", nil, common.ErrInvalidWhereClause
+	}
+
+	separator := " AND "
+	if where.Operator == model.BooleanOperatorOr {
+		separator = " OR "
+	}
+	conditions := make([]string, 0, len(where.Children))
+	var args []interface{}
+	for _, child := range where.Children {
+		condition, childArgs, err := buildMetadataFilter(child)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions = append(conditions, condition)
+		args = append(args, childArgs...)
+	}
+	return "(" + strings.Join(conditions, separator) + ")", args, nil
+}
+
+func buildMetadataComparison(comparison *model.WhereComparison) (string, []interface{}, error) {
+	if len(comparison.Values) == 0 {
+		return "", nil, common.ErrInvalidWhereClause
+	}
+
+	var column string
+	values := make([]interface{}, 0, len(comparison.Values))
+	for _, value := range comparison.Values {
+		var valueColumn string
+		switch v := value.(type) {
+		case *model.CollectionMetadataValueStringType:
+			valueColumn = "str_value"
+			values = append(values, v.Value)
+		case *model.CollectionMetadataValueInt64Type:
+			valueColumn = "int_value"
+			values = append(values, v.Value)
+		case *model.CollectionMetadataValueFloat64Type:
+			valueColumn = "float_value"
+			values = append(values, v.Value)
+		default:
+			return "", nil, common.ErrUnknownCollectionMetadataType
+		}
+		if column != "" && column != valueColumn {
+			return "", nil, common.ErrInvalidWhereClause
+		}
+		column = valueColumn
+	}
+
+	var predicate string
+	var arg interface{} = values[0]
+	switch comparison.Operator {
+	case model.WhereOperatorEq:
+		predicate = "= ?"
+	case model.WhereOperatorNe:
+		predicate = "<> ?"
+	case model.WhereOperatorGt:
+		predicate = "> ?"
+	case model.WhereOperatorGte:
+		predicate = ">= ?"
+	case model.WhereOperatorLt:
+		predicate = "< ?"
+	case model.WhereOperatorLte:
+		predicate = "<= ?"
+	case model.WhereOperatorIn:
+		predicate = "IN ?"
+		arg = values
+	case model.WhereOperatorNin:
+		predicate = "NOT IN ?"
+		arg = values
+	default:
+		return "", nil, common.ErrInvalidWhereClause
+	}
+	condition := "collections.id IN (SELECT collection_metadata.collection_id FROM collection_metadata WHERE collection_metadata.key = ? AND collection_metadata." + column + " " + predicate + ")"
+	return condition, []interface{}{comparison.Key, arg}, nil
+}
+
 func (s *collectionDb) DeleteCollectionByID(collectionID string) (int, error) {
 	var collections []dbmodel.Collection
 	err := s.db.Clauses(clause.Returning{}).Where("id = ?", collectionID).Delete(&collections).Error
 	return len(collections), err
 }
 
+func (s *collectionDb) SoftDeleteByDatabaseID(databaseID string) ([]string, error) {
+	var collections []dbmodel.Collection
+	err := s.db.Model(&collections).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("database_id = ?", databaseID).
+		Where("is_deleted = ?", false).
+		Update("is_deleted", true).Error
+	if err != nil {
+		log.Error("soft delete collections failed", zap.String("databaseID", databaseID), zap.Error(err))
+		return nil, err
+	}
+	collectionIDs := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		collectionIDs = append(collectionIDs, collection.ID)
+	}
+	return collectionIDs, nil
+}
+
 func (s *collectionDb) Insert(in *dbmodel.Collection) error {
 	err := s.db.Create(&in).Error
 	if err != nil {
@@ -197,13 +321,26 @@ func generateCollectionUpdatesWithoutID(in *dbmodel.Collection) map[string]inter
 	if in.Dimension != nil {
 		ret["dimension"] = *in.Dimension
 	}
+	if in.DatabaseID != "" {
+		ret["database_id"] = in.DatabaseID
+	}
 	return ret
 }
 
 func (s *collectionDb) Update(in *dbmodel.Collection) error {
 	log.Info("update collection", zap.Any("collection", in))
 	updates := generateCollectionUpdatesWithoutID(in)
-	return s.db.Model(&dbmodel.Collection{}).Where("id = ?", in.ID).Updates(updates).Error
+	err := s.db.Model(&dbmodel.Collection{}).Where("id = ?", in.ID).Updates(updates).Error
+	if err != nil {
+		log.Error("update collection failed", zap.Error(err))
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			log.Error("collection name already exists in database")
+			return common.ErrCollectionUniqueConstraintViolation
+		}
+		return err
+	}
+	return nil
 }
 
 func (s *collectionDb) UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
@@ -224,10 +361,40 @@ func (s *collectionDb) UpdateLogPositionAndVersion(collectionID string, logPosit
 		return 0, common.ErrCollectionVersionInvalid
 	}
 
-	version := currentCollectionVersion + 1
-	err = s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Updates(map[string]interface{}{"log_position": logPosition, "version": version}).Error
+	return s.updateLogPositionAndVersion(collectionID, logPosition, currentCollectionVersion)
+}
+
+// RestoreLogPositionAndVersion moves the log position of a collection to the one of an
+// earlier version and bumps its version. Unlike UpdateLogPositionAndVersion the log
+// position is allowed to go backwards.
+func (s *collectionDb) RestoreLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
+	log.Info("restore log position and version", zap.String("collectionID", collectionID), zap.Int64("logPosition", logPosition), zap.Int32("currentCollectionVersion", currentCollectionVersion))
+	var collection dbmodel.Collection
+	err := s.db.Where("id = ?", collectionID).First(&collection).Error
 	if err != nil {
 		return 0, err
 	}
+	if collection.Version > currentCollectionVersion {
+		return 0, common.ErrCollectionVersionStale
+	}
+	if collection.Version < currentCollectionVersion {
+		return 0, common.ErrCollectionVersionInvalid
+	}
+
+	return s.updateLogPositionAndVersion(collectionID, logPosition, currentCollectionVersion)
+}
+
+// updateLogPositionAndVersion bumps the version of a collection only if it is still
+// currentCollectionVersion, so that a concurrent compaction or restore that read the
+// same version fails as stale instead of being overwritten.
+func (s *collectionDb) updateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
+	version := currentCollectionVersion + 1
+	result := s.db.Model(&dbmodel.Collection{}).Where("id = ? AND version = ?", collectionID, currentCollectionVersion).Updates(map[string]interface{}{"log_position": logPosition, "version": version})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, common.ErrCollectionVersionStale
+	}
 	return version, nil
 }
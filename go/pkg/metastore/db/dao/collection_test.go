@@ -3,11 +3,14 @@ package dao
 import (
 	"testing"
 
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"gorm.io/gorm"
 )
 
@@ -65,7 +68,7 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 		suite.NoError(err)
 		suite.Equal(collectionID, scanedCollectionID)
 	}
-	collections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
@@ -75,13 +78,13 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	suite.Equal(metadata.StrValue, collections[0].CollectionMetadata[0].StrValue)
 
 	// Test when filtering by ID
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
 
 	// Test when filtering by name
-	collections, err = suite.collectionDb.GetCollections(nil, &collectionName, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err = suite.collectionDb.GetCollections(nil, &collectionName, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
@@ -90,37 +93,159 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	_, err = CreateTestCollection(suite.db, "test_collection_get_collections2", 128, suite.databaseId)
 	suite.NoError(err)
 
-	allCollections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	allCollections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(allCollections, 2)
 
 	limit := int32(1)
 	offset := int32(1)
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, nil)
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(allCollections[0].Collection.ID, collections[0].Collection.ID)
 
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset)
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(allCollections[1].Collection.ID, collections[0].Collection.ID)
 
 	offset = int32(2)
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset)
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset, nil, nil)
 	suite.NoError(err)
 	suite.Nil(collections)
 
+	// Test that a page contains all the metadata of its collections
+	for _, key := range []string{"test1", "test2"} {
+		key := key
+		err = suite.db.Create(&dbmodel.CollectionMetadata{
+			CollectionID: allCollections[0].Collection.ID,
+			Key:          &key,
+			StrValue:     &testValue,
+		}).Error
+		suite.NoError(err)
+	}
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, nil, nil, nil)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(allCollections[0].Collection.ID, collections[0].Collection.ID)
+	suite.Len(collections[0].CollectionMetadata, len(allCollections[0].CollectionMetadata)+2)
+
+	// Test keyset pagination
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, nil, &allCollections[0].Collection.ID, nil)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(allCollections[1].Collection.ID, collections[0].Collection.ID)
+
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, nil, &allCollections[1].Collection.ID, nil)
+	suite.NoError(err)
+	suite.Nil(collections)
+
+	// Test metadata filter
+	versionKey := "version"
+	versionValue := int64(3)
+	otherCollectionID := allCollections[0].Collection.ID
+	if otherCollectionID == collectionID {
+		otherCollectionID = allCollections[1].Collection.ID
+	}
+	err = suite.db.Create(&dbmodel.CollectionMetadata{
+		CollectionID: otherCollectionID,
+		Key:          &versionKey,
+		IntValue:     &versionValue,
+	}).Error
+	suite.NoError(err)
+
+	testEq := &model.Where{Comparison: &model.WhereComparison{
+		Key:      testKey,
+		Operator: model.WhereOperatorEq,
+		Values:   []model.CollectionMetadataValueType{&model.CollectionMetadataValueStringType{Value: testValue}},
+	}}
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil, testEq)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(collectionID, collections[0].Collection.ID)
+	suite.Len(collections[0].CollectionMetadata, 1)
+
+	versionGt := &model.Where{Comparison: &model.WhereComparison{
+		Key:      versionKey,
+		Operator: model.WhereOperatorGt,
+		Values:   []model.CollectionMetadataValueType{&model.CollectionMetadataValueInt64Type{Value: 2}},
+	}}
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil, versionGt)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(otherCollectionID, collections[0].Collection.ID)
+
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil, &model.Where{
+		Children: []*model.Where{testEq, versionGt},
+		Operator: model.BooleanOperatorOr,
+	})
+	suite.NoError(err)
+	suite.Len(collections, 2)
+
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil, &model.Where{
+		Children: []*model.Where{testEq, versionGt},
+		Operator: model.BooleanOperatorAnd,
+	})
+	suite.NoError(err)
+	suite.Nil(collections)
+
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil, &model.Where{Comparison: &model.WhereComparison{
+		Key:      versionKey,
+		Operator: model.WhereOperatorIn,
+		Values: []model.CollectionMetadataValueType{
+			&model.CollectionMetadataValueInt64Type{Value: 3},
+			&model.CollectionMetadataValueInt64Type{Value: 4},
+		},
+	}})
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(otherCollectionID, collections[0].Collection.ID)
+
 	// clean up
 	err = CleanUpTestCollection(suite.db, collectionID)
 	suite.NoError(err)
 }
 
+func (suite *CollectionDbTestSuite) TestCollectionDb_NameUniquePerDatabase() {
+	collectionName := "test_collection_name_unique_per_database"
+	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+	suite.NoError(err)
+
+	// the same name can be used in another database
+	otherTenantName := "test_collection_name_unique_tenant"
+	otherDatabaseName := "test_collection_name_unique_database"
+	otherDatabaseID, err := CreateTestTenantAndDatabase(suite.db, otherTenantName, otherDatabaseName)
+	suite.NoError(err)
+	_, err = CreateTestCollection(suite.db, collectionName, 128, otherDatabaseID)
+	suite.NoError(err)
+
+	// but not twice in the same database
+	err = suite.collectionDb.Insert(&dbmodel.Collection{
+		ID:         types.NewUniqueID().String(),
+		Name:       &collectionName,
+		DatabaseID: suite.databaseId,
+	})
+	suite.Equal(common.ErrCollectionUniqueConstraintViolation, err)
+
+	// renaming onto an existing name is rejected as well
+	otherName := "test_collection_name_unique_per_database2"
+	otherCollectionID, err := CreateTestCollection(suite.db, otherName, 128, suite.databaseId)
+	suite.NoError(err)
+	err = suite.collectionDb.Update(&dbmodel.Collection{ID: otherCollectionID, Name: &collectionName})
+	suite.Equal(common.ErrCollectionUniqueConstraintViolation, err)
+
+	// clean up
+	suite.NoError(CleanUpTestCollection(suite.db, collectionID))
+	suite.NoError(CleanUpTestCollection(suite.db, otherCollectionID))
+	suite.NoError(CleanUpTestDatabase(suite.db, otherTenantName, otherDatabaseName))
+	suite.NoError(CleanUpTestTenant(suite.db, otherTenantName))
+}
+
 func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion() {
 	collectionName := "test_collection_get_collections"
 	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
 	// verify default values
-	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil)
+	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(int64(0), collections[0].Collection.LogPosition)
@@ -130,7 +255,7 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion
 	version, err := suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(10), 0)
 	suite.NoError(err)
 	suite.Equal(int32(1), version)
-	collections, err = suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil)
+	collections, err = suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil, nil)
 	suite.Len(collections, 1)
 	suite.Equal(int64(10), collections[0].Collection.LogPosition)
 	suite.Equal(int32(1), collections[0].Collection.Version)
@@ -150,6 +275,34 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion
 	suite.NoError(err)
 }
 
+func (suite *CollectionDbTestSuite) TestCollectionDb_RestoreLogPositionAndVersion() {
+	collectionName := "test_collection_restore_log_position"
+	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+	suite.NoError(err)
+	_, err = suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(10), 0)
+	suite.NoError(err)
+
+	// the log position can go backwards
+	version, err := suite.collectionDb.RestoreLogPositionAndVersion(collectionID, int64(5), 1)
+	suite.NoError(err)
+	suite.Equal(int32(2), version)
+	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil, nil)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(int64(5), collections[0].Collection.LogPosition)
+	suite.Equal(int32(2), collections[0].Collection.Version)
+
+	// invalid version
+	_, err = suite.collectionDb.RestoreLogPositionAndVersion(collectionID, int64(5), 1)
+	suite.Equal(common.ErrCollectionVersionStale, err)
+	_, err = suite.collectionDb.RestoreLogPositionAndVersion(collectionID, int64(5), 3)
+	suite.Equal(common.ErrCollectionVersionInvalid, err)
+
+	//clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
 func TestCollectionDbTestSuiteSuite(t *testing.T) {
 	testSuite := new(CollectionDbTestSuite)
 	suite.Run(t, testSuite)
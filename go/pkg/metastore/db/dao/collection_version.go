@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type collectionVersionDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionVersionDb = &collectionVersionDb{}
+
+func (s *collectionVersionDb) Insert(in *dbmodel.CollectionVersion) error {
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert collection version failed", zap.String("collectionID", in.CollectionID), zap.Int32("version", in.Version), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// GetCollectionVersions returns the versions of a collection newer than
+// startAfterVersion in ascending order. A limit of 0 returns all of them.
+func (s *collectionVersionDb) GetCollectionVersions(collectionID string, startAfterVersion int32, limit int32) ([]*dbmodel.CollectionVersion, error) {
+	var versions []*dbmodel.CollectionVersion
+	query := s.db.Where("collection_id = ? AND version > ?", collectionID, startAfterVersion).Order("version ASC")
+	if limit > 0 {
+		query = query.Limit(int(limit))
+	}
+	err := query.Find(&versions).Error
+	if err != nil {
+		log.Error("get collection versions failed", zap.String("collectionID", collectionID), zap.Error(err))
+		return nil, err
+	}
+	return versions, nil
+}
+
+func (s *collectionVersionDb) DeleteByCollectionID(collectionID string) error {
+	return s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.CollectionVersion{}).Error
+}
+
+func (s *collectionVersionDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionVersion{}).Error
+}
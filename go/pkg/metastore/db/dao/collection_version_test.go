@@ -0,0 +1,73 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type CollectionVersionDbTestSuite struct {
+	suite.Suite
+	db                  *gorm.DB
+	collectionVersionDb *collectionVersionDb
+}
+
+func (suite *CollectionVersionDbTestSuite) SetupSuite() {
+	log.Info("setup suite")
+	suite.db = dbcore.ConfigDatabaseForTesting()
+	suite.collectionVersionDb = &collectionVersionDb{
+		db: suite.db,
+	}
+}
+
+func (suite *CollectionVersionDbTestSuite) TearDownTest() {
+	suite.NoError(suite.collectionVersionDb.DeleteAll())
+}
+
+func (suite *CollectionVersionDbTestSuite) TestCollectionVersionDb_GetCollectionVersions() {
+	collectionID := types.NewUniqueID().String()
+	segmentID := types.NewUniqueID().String()
+	for version := int32(1); version <= 3; version++ {
+		err := suite.collectionVersionDb.Insert(&dbmodel.CollectionVersion{
+			CollectionID: collectionID,
+			Version:      version,
+			LogPosition:  int64(version) * 10,
+			SegmentFilePaths: map[string]map[string][]string{
+				segmentID: {"hnsw": {"file"}},
+			},
+		})
+		suite.NoError(err)
+	}
+
+	// versions are immutable
+	err := suite.collectionVersionDb.Insert(&dbmodel.CollectionVersion{CollectionID: collectionID, Version: 1})
+	suite.Error(err)
+
+	versions, err := suite.collectionVersionDb.GetCollectionVersions(collectionID, 0, 0)
+	suite.NoError(err)
+	suite.Len(versions, 3)
+	suite.Equal(int32(1), versions[0].Version)
+	suite.Equal(int64(10), versions[0].LogPosition)
+	suite.Equal(map[string]map[string][]string{segmentID: {"hnsw": {"file"}}}, versions[0].SegmentFilePaths)
+
+	versions, err = suite.collectionVersionDb.GetCollectionVersions(collectionID, 1, 1)
+	suite.NoError(err)
+	suite.Len(versions, 1)
+	suite.Equal(int32(2), versions[0].Version)
+
+	err = suite.collectionVersionDb.DeleteByCollectionID(collectionID)
+	suite.NoError(err)
+	versions, err = suite.collectionVersionDb.GetCollectionVersions(collectionID, 0, 0)
+	suite.NoError(err)
+	suite.Empty(versions)
+}
+
+func TestCollectionVersionDbTestSuite(t *testing.T) {
+	testSuite := new(CollectionVersionDbTestSuite)
+	suite.Run(t, testSuite)
+}
@@ -40,3 +40,11 @@ func (*metaDomain) SegmentMetadataDb(ctx context.Context) dbmodel.ISegmentMetada
 func (*metaDomain) NotificationDb(ctx context.Context) dbmodel.INotificationDb {
 	return &notificationDb{dbcore.GetDB(ctx)}
 }
+
+func (*metaDomain) FileReferenceDb(ctx context.Context) dbmodel.IFileReferenceDb {
+	return &fileReferenceDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionVersionDb(ctx context.Context) dbmodel.ICollectionVersionDb {
+	return &collectionVersionDb{dbcore.GetDB(ctx)}
+}
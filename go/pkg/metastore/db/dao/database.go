@@ -24,9 +24,18 @@ func (s *databaseDb) DeleteByTenantIdAndName(tenantId string, databaseName strin
 	return len(databases), err
 }
 
+func (s *databaseDb) SoftDeleteByID(databaseID string) (int, error) {
+	result := s.db.Model(&dbmodel.Database{}).
+		Where("id = ?", databaseID).
+		Where("is_deleted = ?", false).
+		Update("is_deleted", true)
+	return int(result.RowsAffected), result.Error
+}
+
 func (s *databaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 	var databases []*dbmodel.Database
-	query := s.db.Table("databases")
+	query := s.db.Table("databases").
+		Where("databases.is_deleted = ?", false)
 
 	if err := query.Find(&databases).Error; err != nil {
 		return nil, err
@@ -39,7 +48,8 @@ func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmo
 	query := s.db.Table("databases").
 		Select("databases.id, databases.name, databases.tenant_id").
 		Where("databases.name = ?", databaseName).
-		Where("databases.tenant_id = ?", tenantID)
+		Where("databases.tenant_id = ?", tenantID).
+		Where("databases.is_deleted = ?", false)
 
 	if err := query.Find(&databases).Error; err != nil {
 		log.Error("GetDatabases", zap.Error(err))
@@ -48,6 +58,27 @@ func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmo
 	return databases, nil
 }
 
+// ListDatabases returns up to limit databases of a tenant ordered by name, starting
+// after the given name.
+func (s *databaseDb) ListDatabases(tenantID string, startAfter string, limit int32) ([]*dbmodel.Database, error) {
+	var databases []*dbmodel.Database
+	query := s.db.Table("databases").
+		Select("databases.id, databases.name, databases.tenant_id").
+		Where("databases.tenant_id = ?", tenantID).
+		Where("databases.is_deleted = ?", false).
+		Order("databases.name asc").
+		Limit(int(limit))
+	if startAfter != "" {
+		query = query.Where("databases.name > ?", startAfter)
+	}
+
+	if err := query.Find(&databases).Error; err != nil {
+		log.Error("ListDatabases", zap.Error(err))
+		return nil, err
+	}
+	return databases, nil
+}
+
 func (s *databaseDb) Insert(database *dbmodel.Database) error {
 	return s.db.Create(database).Error
 }
@@ -56,7 +87,8 @@ func (s *databaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Databas
 	var databases []*dbmodel.Database
 	query := s.db.Table("databases").
 		Select("databases.id, databases.name, databases.tenant_id").
-		Where("databases.tenant_id = ?", tenantID)
+		Where("databases.tenant_id = ?", tenantID).
+		Where("databases.is_deleted = ?", false)
 
 	if err := query.Find(&databases).Error; err != nil {
 		log.Error("GetDatabasesByTenantID", zap.Error(err))
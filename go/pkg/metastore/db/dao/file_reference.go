@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type fileReferenceDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IFileReferenceDb = &fileReferenceDb{}
+
+func (s *fileReferenceDb) Insert(in []*dbmodel.FileReference) error {
+	if len(in) == 0 {
+		return nil
+	}
+	return s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(in).Error
+}
+
+// DeleteBySegmentID drops the references of the files the segment uses now, the
+// references of the collection versions are kept.
+func (s *fileReferenceDb) DeleteBySegmentID(segmentID string) error {
+	return s.db.Where("segment_id = ? AND version = 0", segmentID).Delete(&dbmodel.FileReference{}).Error
+}
+
+func (s *fileReferenceDb) DeleteByCollectionID(collectionID string) error {
+	return s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.FileReference{}).Error
+}
+
+// GetReferenceCounts returns the number of segments and collection versions
+// referencing each of the given files. Files that are not referenced at all are left out of the result.
+func (s *fileReferenceDb) GetReferenceCounts(filePaths []string) (map[string]int64, error) {
+	var rows []struct {
+		FilePath string
+		Count    int64
+	}
+	err := s.db.Model(&dbmodel.FileReference{}).
+		Select("file_path, COUNT(*) AS count").
+		Where("file_path IN ?", filePaths).
+		Group("file_path").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.FilePath] = row.Count
+	}
+	return counts, nil
+}
+
+func (s *fileReferenceDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.FileReference{}).Error
+}
@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type FileReferenceDbTestSuite struct {
+	suite.Suite
+	db              *gorm.DB
+	fileReferenceDb *fileReferenceDb
+}
+
+func (suite *FileReferenceDbTestSuite) SetupSuite() {
+	log.Info("setup suite")
+	suite.db = dbcore.ConfigDatabaseForTesting()
+	suite.fileReferenceDb = &fileReferenceDb{
+		db: suite.db,
+	}
+}
+
+func (suite *FileReferenceDbTestSuite) TearDownTest() {
+	suite.NoError(suite.fileReferenceDb.DeleteAll())
+}
+
+func (suite *FileReferenceDbTestSuite) TestFileReferenceDb_GetReferenceCounts() {
+	err := suite.fileReferenceDb.Insert([]*dbmodel.FileReference{
+		{FilePath: "f1", SegmentID: "source_segment", CollectionID: "source"},
+		{FilePath: "f2", SegmentID: "source_segment", CollectionID: "source"},
+		{FilePath: "f1", SegmentID: "fork_segment", CollectionID: "fork"},
+	})
+	suite.NoError(err)
+
+	// inserting an existing reference again is a no-op
+	err = suite.fileReferenceDb.Insert([]*dbmodel.FileReference{
+		{FilePath: "f1", SegmentID: "source_segment", CollectionID: "source"},
+	})
+	suite.NoError(err)
+
+	counts, err := suite.fileReferenceDb.GetReferenceCounts([]string{"f1", "f2", "f3"})
+	suite.NoError(err)
+	suite.Equal(map[string]int64{"f1": 2, "f2": 1}, counts)
+
+	// the files of a version are referenced until the version is dropped
+	err = suite.fileReferenceDb.Insert([]*dbmodel.FileReference{
+		{FilePath: "f3", SegmentID: "fork_segment", CollectionID: "fork", Version: 1},
+	})
+	suite.NoError(err)
+	err = suite.fileReferenceDb.DeleteBySegmentID("fork_segment")
+	suite.NoError(err)
+	counts, err = suite.fileReferenceDb.GetReferenceCounts([]string{"f1", "f2", "f3"})
+	suite.NoError(err)
+	suite.Equal(map[string]int64{"f1": 1, "f2": 1, "f3": 1}, counts)
+	err = suite.fileReferenceDb.DeleteByCollectionID("fork")
+	suite.NoError(err)
+
+	err = suite.fileReferenceDb.DeleteByCollectionID("source")
+	suite.NoError(err)
+	counts, err = suite.fileReferenceDb.GetReferenceCounts([]string{"f1", "f2", "f3"})
+	suite.NoError(err)
+	suite.Empty(counts)
+}
+
+func TestFileReferenceDbTestSuite(t *testing.T) {
+	testSuite := new(FileReferenceDbTestSuite)
+	suite.Run(t, testSuite)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type segmentDb struct {
@@ -28,6 +29,24 @@ func (s *segmentDb) DeleteSegmentByID(id string) error {
 	return s.db.Where("id = ?", id).Delete(&dbmodel.Segment{}).Error
 }
 
+func (s *segmentDb) SoftDeleteByCollectionID(collectionID string) ([]string, error) {
+	var segments []dbmodel.Segment
+	err := s.db.Model(&segments).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("collection_id = ?", collectionID).
+		Where("is_deleted = ?", false).
+		Update("is_deleted", true).Error
+	if err != nil {
+		log.Error("soft delete segments failed", zap.String("collectionID", collectionID), zap.Error(err))
+		return nil, err
+	}
+	segmentIDs := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		segmentIDs = append(segmentIDs, segment.ID)
+	}
+	return segmentIDs, nil
+}
+
 func (s *segmentDb) Insert(in *dbmodel.Segment) error {
 	err := s.db.Create(&in).Error
 
@@ -48,8 +67,6 @@ func (s *segmentDb) Insert(in *dbmodel.Segment) error {
 		return err
 	}
 	return nil
-
-	return nil
 }
 
 func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*dbmodel.SegmentAndMetadata, error) {
@@ -58,6 +75,7 @@ func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *s
 	query := s.db.Table("segments").
 		Select("segments.id, segments.collection_id, segments.type, segments.scope, segments.file_paths, segment_metadata.key, segment_metadata.str_value, segment_metadata.int_value, segment_metadata.float_value").
 		Joins("LEFT JOIN segment_metadata ON segments.id = segment_metadata.segment_id").
+		Where("segments.is_deleted = ?", false).
 		Order("segments.id")
 
 	if id != types.NilUniqueID() {
@@ -27,10 +27,18 @@ func (s *tenantDb) DeleteByID(tenantID string) (int, error) {
 	return len(tenants), err
 }
 
+func (s *tenantDb) SoftDeleteByID(tenantID string) (int, error) {
+	result := s.db.Model(&dbmodel.Tenant{}).
+		Where("id = ?", tenantID).
+		Where("is_deleted = ?", false).
+		Update("is_deleted", true)
+	return int(result.RowsAffected), result.Error
+}
+
 func (s *tenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
 	var tenants []*dbmodel.Tenant
 
-	if err := s.db.Find(&tenants).Error; err != nil {
+	if err := s.db.Where("is_deleted = ?", false).Find(&tenants).Error; err != nil {
 		return nil, err
 	}
 	return tenants, nil
@@ -39,14 +47,42 @@ func (s *tenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
 func (s *tenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	var tenants []*dbmodel.Tenant
 
-	if err := s.db.Where("id = ?", tenantID).Find(&tenants).Error; err != nil {
+	if err := s.db.Where("id = ?", tenantID).Where("is_deleted = ?", false).Find(&tenants).Error; err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+// ListTenants returns up to limit tenants ordered by id, starting after the given id.
+func (s *tenantDb) ListTenants(startAfter string, limit int32) ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+
+	query := s.db.Where("is_deleted = ?", false).Order("id asc").Limit(int(limit))
+	if startAfter != "" {
+		query = query.Where("id > ?", startAfter)
+	}
+	if err := query.Find(&tenants).Error; err != nil {
+		log.Error("ListTenants", zap.Error(err))
 		return nil, err
 	}
 	return tenants, nil
 }
 
+// Insert creates a tenant. The id of a soft deleted tenant is reused, its row is
+// replaced by the new tenant.
 func (s *tenantDb) Insert(tenant *dbmodel.Tenant) error {
-	err := s.db.Create(tenant).Error
+	result := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ts", "is_deleted", "created_at", "updated_at", "last_compaction_time"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Eq{Column: clause.Column{Table: "tenants", Name: "is_deleted"}, Value: true},
+		}},
+	}).Create(tenant)
+	if result.Error == nil && result.RowsAffected == 0 {
+		log.Error("tenant already exists")
+		return common.ErrTenantUniqueConstraintViolation
+	}
+	err := result.Error
 	if err != nil {
 		log.Error("create tenant failed", zap.Error(err))
 		var pgErr *pgconn.PgError
@@ -72,6 +108,7 @@ func (s *tenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactio
 	result := s.db.Model(&tenants).
 		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
 		Where("id = ?", tenantID).
+		Where("is_deleted = ?", false).
 		Update("last_compaction_time", lastCompactionTime)
 
 	if result.Error != nil {
@@ -88,7 +125,7 @@ func (s *tenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.
 	log.Info("GetTenantsLastCompactionTime", zap.Any("tenantIDs", tenantIDs))
 	var tenants []*dbmodel.Tenant
 
-	result := s.db.Select("id", "last_compaction_time").Find(&tenants, "id IN ?", tenantIDs)
+	result := s.db.Select("id", "last_compaction_time").Where("is_deleted = ?", false).Find(&tenants, "id IN ?", tenantIDs)
 	if result.Error != nil {
 		log.Error("GetTenantsLastCompactionTime error", zap.Error(result.Error))
 		return nil, result.Error
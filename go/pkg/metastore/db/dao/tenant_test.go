@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/pingcap/log"
@@ -95,6 +96,26 @@ func (suite *TenantDbTestSuite) TestTenantDb_GetTenantsLastCompactionTime() {
 	}
 }
 
+func (suite *TenantDbTestSuite) TestTenantDb_InsertAfterSoftDelete() {
+	tenantId := "testInsertAfterSoftDelete"
+	err := suite.Db.Insert(&dbmodel.Tenant{ID: tenantId, LastCompactionTime: 1})
+	suite.Require().NoError(err)
+	err = suite.Db.Insert(&dbmodel.Tenant{ID: tenantId, LastCompactionTime: 1})
+	suite.Require().Equal(common.ErrTenantUniqueConstraintViolation, err)
+
+	deleted, err := suite.Db.SoftDeleteByID(tenantId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, deleted)
+	err = suite.Db.Insert(&dbmodel.Tenant{ID: tenantId, LastCompactionTime: 2})
+	suite.Require().NoError(err)
+	tenants, err := suite.Db.GetTenants(tenantId)
+	suite.Require().NoError(err)
+	suite.Require().Len(tenants, 1)
+	suite.Require().Equal(int64(2), tenants[0].LastCompactionTime)
+
+	suite.db.Delete(&dbmodel.Tenant{}, "id = ?", tenantId)
+}
+
 func TestTenantDbTestSuite(t *testing.T) {
 	testSuite := new(TenantDbTestSuite)
 	testSuite.t = t
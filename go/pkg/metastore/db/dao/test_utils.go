@@ -52,7 +52,7 @@ func CleanUpTestDatabase(db *gorm.DB, tenantName string, databaseName string) er
 	collectionDb := &collectionDb{
 		db: db,
 	}
-	collections, err := collectionDb.GetCollections(nil, nil, tenantName, databaseName, nil, nil)
+	collections, err := collectionDb.GetCollections(nil, nil, tenantName, databaseName, nil, nil, nil, nil)
 	log.Info("clean up test database", zap.Int("collections", len(collections)))
 	if err != nil {
 		return err
@@ -184,6 +184,14 @@ func CreateTestTables(db *gorm.DB) {
 	if !tableExist {
 		db.Migrator().CreateTable(&dbmodel.Notification{})
 	}
+	tableExist = db.Migrator().HasTable(&dbmodel.FileReference{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.FileReference{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionVersion{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionVersion{})
+	}
 
 	// create default tenant and database
 	CreateDefaultTenantAndDatabase(db)
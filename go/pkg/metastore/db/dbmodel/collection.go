@@ -3,14 +3,15 @@ package dbmodel
 import (
 	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
 type Collection struct {
 	ID          string          `gorm:"id;primaryKey"`
-	Name        *string         `gorm:"name;unique"`
+	Name        *string         `gorm:"name;uniqueIndex:uni_collections_database_id_name,priority:2,where:is_deleted = false"`
 	Dimension   *int32          `gorm:"dimension"`
-	DatabaseID  string          `gorm:"database_id"`
+	DatabaseID  string          `gorm:"database_id;uniqueIndex:uni_collections_database_id_name,priority:1,where:is_deleted = false"`
 	Ts          types.Timestamp `gorm:"ts;type:bigint;default:0"`
 	IsDeleted   bool            `gorm:"is_deleted;type:bool;default:false"`
 	CreatedAt   time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
@@ -32,10 +33,12 @@ type CollectionAndMetadata struct {
 
 //go:generate mockery --name=ICollectionDb
 type ICollectionDb interface {
-	GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*CollectionAndMetadata, error)
+	GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, startAfterID *string, where *model.Where) ([]*CollectionAndMetadata, error)
 	DeleteCollectionByID(collectionID string) (int, error)
+	SoftDeleteByDatabaseID(databaseID string) ([]string, error)
 	Insert(in *Collection) error
 	Update(in *Collection) error
 	DeleteAll() error
 	UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error)
+	RestoreLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error)
 }
@@ -0,0 +1,28 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// CollectionVersion is an immutable record of a compaction. It keeps the version
+// and log position the compaction moved the collection to, and the files of every
+// segment it flushed, keyed by segment id.
+type CollectionVersion struct {
+	CollectionID     string                         `gorm:"collection_id;primaryKey"`
+	Version          int32                          `gorm:"version;primaryKey;autoIncrement:false"`
+	LogPosition      int64                          `gorm:"log_position"`
+	SegmentFilePaths map[string]map[string][]string `gorm:"segment_file_paths;serializer:json;default:'{}'"`
+	CreatedAt        time.Time                      `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (CollectionVersion) TableName() string {
+	return "collection_versions"
+}
+
+//go:generate mockery --name=ICollectionVersionDb
+type ICollectionVersionDb interface {
+	Insert(in *CollectionVersion) error
+	GetCollectionVersions(collectionID string, startAfterVersion int32, limit int32) ([]*CollectionVersion, error)
+	DeleteByCollectionID(collectionID string) error
+	DeleteAll() error
+}
@@ -15,6 +15,8 @@ type IMetaDomain interface {
 	SegmentDb(ctx context.Context) ISegmentDb
 	SegmentMetadataDb(ctx context.Context) ISegmentMetadataDb
 	NotificationDb(ctx context.Context) INotificationDb
+	FileReferenceDb(ctx context.Context) IFileReferenceDb
+	CollectionVersionDb(ctx context.Context) ICollectionVersionDb
 }
 
 //go:generate mockery --name=ITransaction
@@ -8,8 +8,8 @@ import (
 
 type Database struct {
 	ID        string          `gorm:"id;primaryKey;unique"`
-	Name      string          `gorm:"name;type:varchar(128);not_null;uniqueIndex:idx_tenantid_name"`
-	TenantID  string          `gorm:"tenant_id;type:varchar(128);not_null;uniqueIndex:idx_tenantid_name"`
+	Name      string          `gorm:"name;type:varchar(128);not_null;uniqueIndex:idx_tenantid_name,where:is_deleted = false"`
+	TenantID  string          `gorm:"tenant_id;type:varchar(128);not_null;uniqueIndex:idx_tenantid_name,where:is_deleted = false"`
 	Ts        types.Timestamp `gorm:"ts;type:bigint;default:0"`
 	IsDeleted bool            `gorm:"is_deleted;type:bool;default:false"`
 	CreatedAt time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
@@ -24,6 +24,9 @@ func (v Database) TableName() string {
 type IDatabaseDb interface {
 	GetAllDatabases() ([]*Database, error)
 	GetDatabases(tenantID string, databaseName string) ([]*Database, error)
+	GetDatabasesByTenantID(tenantID string) ([]*Database, error)
+	ListDatabases(tenantID string, startAfter string, limit int32) ([]*Database, error)
 	Insert(in *Database) error
+	SoftDeleteByID(databaseID string) (int, error)
 	DeleteAll() error
 }
@@ -0,0 +1,32 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// FileReference records that a segment uses a file. Forked collections share the
+// files of their source, so a file may only be garbage collected once no segment
+// references it anymore. The files of a collection version are referenced with
+// its version until the version is dropped with its collection, so that the
+// collection can be restored to it.
+type FileReference struct {
+	FilePath  string `gorm:"file_path;primaryKey"`
+	SegmentID string `gorm:"segment_id;primaryKey"`
+	// Version is 0 for the files the segment uses now
+	Version      int32     `gorm:"version;primaryKey;autoIncrement:false;not null;default:0"`
+	CollectionID string    `gorm:"collection_id;index"`
+	CreatedAt    time.Time `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
+}
+
+func (FileReference) TableName() string {
+	return "file_references"
+}
+
+//go:generate mockery --name=IFileReferenceDb
+type IFileReferenceDb interface {
+	Insert(in []*FileReference) error
+	DeleteBySegmentID(segmentID string) error
+	DeleteByCollectionID(collectionID string) error
+	GetReferenceCounts(filePaths []string) (map[string]int64, error)
+	DeleteAll() error
+}
@@ -10,6 +10,7 @@ type Notification struct {
 const (
 	NotificationTypeCreateCollection = "create_collection"
 	NotificationTypeDeleteCollection = "delete_collection"
+	NotificationTypeMoveCollection   = "move_collection"
 )
 
 const (
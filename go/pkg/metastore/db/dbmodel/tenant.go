@@ -23,7 +23,9 @@ func (v Tenant) TableName() string {
 type ITenantDb interface {
 	GetAllTenants() ([]*Tenant, error)
 	GetTenants(tenantID string) ([]*Tenant, error)
+	ListTenants(startAfter string, limit int32) ([]*Tenant, error)
 	Insert(in *Tenant) error
+	SoftDeleteByID(tenantID string) (int, error)
 	DeleteAll() error
 	UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error
 	GetTenantsLastCompactionTime(tenantIDs []string) ([]*Tenant, error)
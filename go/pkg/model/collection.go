@@ -45,6 +45,25 @@ type UpdateCollection struct {
 	Ts            types.Timestamp
 }
 
+// MoveCollection moves a collection to another database of the same tenant,
+// optionally renaming it on the way.
+type MoveCollection struct {
+	ID           types.UniqueID
+	Name         *string
+	TenantID     string
+	DatabaseName string
+	Ts           types.Timestamp
+}
+
+// ForkCollection creates a copy of a collection in the database of the source
+// collection. The copy shares the files of the source segments.
+type ForkCollection struct {
+	SourceCollectionID   types.UniqueID
+	TargetCollectionID   types.UniqueID
+	TargetCollectionName string
+	Ts                   types.Timestamp
+}
+
 type FlushCollectionCompaction struct {
 	ID                       types.UniqueID
 	TenantID                 string
@@ -53,6 +72,30 @@ type FlushCollectionCompaction struct {
 	FlushSegmentCompactions  []*FlushSegmentCompaction
 }
 
+// CollectionVersion is what a compaction produced: the version and log position it
+// moved the collection to and the files of the segments it flushed.
+type CollectionVersion struct {
+	CollectionID            types.UniqueID
+	Version                 int32
+	LogPosition             int64
+	FlushSegmentCompactions []*FlushSegmentCompaction
+	CreatedAt               int64
+}
+
+type GetCollectionVersions struct {
+	CollectionID      types.UniqueID
+	StartAfterVersion int32
+	Limit             int32
+}
+
+// RestoreCollectionVersion resets the segment files and the log position of a
+// collection to what an earlier compaction produced.
+type RestoreCollectionVersion struct {
+	CollectionID types.UniqueID
+	Version      int32
+	Ts           types.Timestamp
+}
+
 type FlushCollectionInfo struct {
 	ID                       string
 	CollectionVersion        int32
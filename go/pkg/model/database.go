@@ -22,3 +22,16 @@ type GetDatabase struct {
 	Tenant string
 	Ts     types.Timestamp
 }
+
+type DeleteDatabase struct {
+	Name   string
+	Tenant string
+	Ts     types.Timestamp
+}
+
+type ListDatabases struct {
+	Tenant     string
+	StartAfter string
+	Limit      int32
+	Ts         types.Timestamp
+}
@@ -3,6 +3,7 @@ package model
 const (
 	NotificationTypeCreateCollection = "create_collection"
 	NotificationTypeDeleteCollection = "delete_collection"
+	NotificationTypeMoveCollection   = "move_collection"
 )
 
 const (
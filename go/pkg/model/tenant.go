@@ -16,7 +16,18 @@ type GetTenant struct {
 	Ts   types.Timestamp
 }
 
+type DeleteTenant struct {
+	Name string
+	Ts   types.Timestamp
+}
+
 type TenantLastCompactionTime struct {
 	ID string
 	Ts types.Timestamp
 }
+
+type ListTenants struct {
+	StartAfter string
+	Limit      int32
+	Ts         types.Timestamp
+}
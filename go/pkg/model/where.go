@@ -0,0 +1,133 @@
+package model
+
+type BooleanOperator int
+
+const (
+	BooleanOperatorAnd BooleanOperator = iota
+	BooleanOperatorOr
+)
+
+type WhereOperator string
+
+const (
+	WhereOperatorEq  WhereOperator = "$eq"
+	WhereOperatorNe  WhereOperator = "$ne"
+	WhereOperatorGt  WhereOperator = "$gt"
+	WhereOperatorGte WhereOperator = "$gte"
+	WhereOperatorLt  WhereOperator = "$lt"
+	WhereOperatorLte WhereOperator = "$lte"
+	WhereOperatorIn  WhereOperator = "$in"
+	WhereOperatorNin WhereOperator = "$nin"
+)
+
+// Where is a predicate on collection metadata. Each node is either a comparison
+// between a metadata key and one or more values, or a list of children combined
+// with a boolean operator.
+type Where struct {
+	Comparison *WhereComparison
+	Children   []*Where
+	Operator   BooleanOperator
+}
+
+// WhereComparison compares the value of a metadata key. $in and $nin take any
+// number of values, the other operators take exactly one. All the values have
+// the same type, and a comparison only matches metadata values of that type.
+type WhereComparison struct {
+	Key      string
+	Operator WhereOperator
+	Values   []CollectionMetadataValueType
+}
+
+// Matches evaluates the predicate against the metadata of a collection.
+func (w *Where) Matches(metadata *CollectionMetadata[CollectionMetadataValueType]) bool {
+	if w == nil {
+		return true
+	}
+	if w.Comparison != nil {
+		return w.Comparison.Matches(metadata)
+	}
+	for _, child := range w.Children {
+		matched := child.Matches(metadata)
+		if w.Operator == BooleanOperatorOr && matched {
+			return true
+		}
+		if w.Operator == BooleanOperatorAnd && !matched {
+			return false
+		}
+	}
+	return w.Operator == BooleanOperatorAnd
+}
+
+func (c *WhereComparison) Matches(metadata *CollectionMetadata[CollectionMetadataValueType]) bool {
+	if metadata == nil || len(c.Values) == 0 {
+		return false
+	}
+	value, ok := metadata.Metadata[c.Key]
+	if !ok {
+		return false
+	}
+
+	switch c.Operator {
+	case WhereOperatorIn, WhereOperatorNin:
+		found := false
+		for _, expected := range c.Values {
+			if _, ok := compareMetadataValues(value, expected); !ok {
+				return false
+			}
+			if value.Equals(expected) {
+				found = true
+			}
+		}
+		return found == (c.Operator == WhereOperatorIn)
+	}
+
+	cmp, ok := compareMetadataValues(value, c.Values[0])
+	if !ok {
+		return false
+	}
+	switch c.Operator {
+	case WhereOperatorEq:
+		return cmp == 0
+	case WhereOperatorNe:
+		return cmp != 0
+	case WhereOperatorGt:
+		return cmp > 0
+	case WhereOperatorGte:
+		return cmp >= 0
+	case WhereOperatorLt:
+		return cmp < 0
+	case WhereOperatorLte:
+		return cmp <= 0
+	}
+	return false
+}
+
+// compareMetadataValues returns -1, 0 or 1 as a is less than, equal to or greater
+// than b. The second return value is false when the values have different types.
+func compareMetadataValues(a CollectionMetadataValueType, b CollectionMetadataValueType) (int, bool) {
+	switch av := a.(type) {
+	case *CollectionMetadataValueStringType:
+		if bv, ok := b.(*CollectionMetadataValueStringType); ok {
+			return compareOrdered(av.Value, bv.Value), true
+		}
+	case *CollectionMetadataValueInt64Type:
+		if bv, ok := b.(*CollectionMetadataValueInt64Type); ok {
+			return compareOrdered(av.Value, bv.Value), true
+		}
+	case *CollectionMetadataValueFloat64Type:
+		if bv, ok := b.(*CollectionMetadataValueFloat64Type); ok {
+			return compareOrdered(av.Value, bv.Value), true
+		}
+	}
+	return 0, false
+}
+
+func compareOrdered[T string | int64 | float64](a T, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
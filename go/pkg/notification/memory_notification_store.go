@@ -3,11 +3,15 @@ package notification
 import (
 	"context"
 	"sort"
+	"sync"
 
 	"github.com/chroma-core/chroma/go/pkg/model"
 )
 
 type MemoryNotificationStore struct {
+	// mu guards notifications, the notification processor reads them while the
+	// catalog adds new ones
+	mu            sync.Mutex
 	notifications map[string][]model.Notification
 }
 
@@ -20,6 +24,8 @@ func NewMemoryNotificationStore() *MemoryNotificationStore {
 }
 
 func (m *MemoryNotificationStore) GetAllPendingNotifications(ctx context.Context) (map[string][]model.Notification, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	result := make(map[string][]model.Notification)
 	for collectionID, notifications := range m.notifications {
 		for _, notification := range notifications {
@@ -36,10 +42,13 @@ func (m *MemoryNotificationStore) GetAllPendingNotifications(ctx context.Context
 }
 
 func (m *MemoryNotificationStore) GetNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	notifications, ok := m.notifications[collectionID]
 	if !ok {
 		return nil, nil
 	}
+	notifications = append([]model.Notification(nil), notifications...)
 	// sort notifications by ID
 	sort.Slice(notifications, func(i, j int) bool {
 		return notifications[i].ID < notifications[j].ID
@@ -48,11 +57,15 @@ func (m *MemoryNotificationStore) GetNotifications(ctx context.Context, collecti
 }
 
 func (m *MemoryNotificationStore) AddNotification(ctx context.Context, notification model.Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.notifications[notification.CollectionID] = append(m.notifications[notification.CollectionID], notification)
 	return nil
 }
 
 func (m *MemoryNotificationStore) RemoveNotifications(ctx context.Context, notifications []model.Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	for _, notification := range notifications {
 		for i, n := range m.notifications[notification.CollectionID] {
 			if n.ID == notification.ID {
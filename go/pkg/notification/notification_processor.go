@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
@@ -32,6 +33,10 @@ type TriggerMessage struct {
 
 const triggerChannelSize = 1000
 
+// pendingNotificationsInterval is how often the notifications left in the store,
+// e.g. after the notifier failed, are sent again.
+const pendingNotificationsInterval = 10 * time.Second
+
 var _ NotificationProcessor = &SimpleNotificationProcessor{}
 
 func NewSimpleNotificationProcessor(ctx context.Context, store NotificationStore, notifier Notifier) *SimpleNotificationProcessor {
@@ -65,8 +70,12 @@ func (n *SimpleNotificationProcessor) Stop() error {
 
 func (n *SimpleNotificationProcessor) Process(ctx context.Context) error {
 	log.Info("Waiting for new notifications")
+	ticker := time.NewTicker(pendingNotificationsInterval)
+	defer ticker.Stop()
 	for {
 		select {
+		case <-ticker.C:
+			_ = n.sendPendingNotifications(ctx)
 		case triggerMsg := <-n.channel:
 			msg := triggerMsg.Msg
 			log.Info("Received notification", zap.Any("msg", msg))
@@ -122,17 +131,16 @@ func (n *SimpleNotificationProcessor) sendPendingNotifications(ctx context.Conte
 		log.Error("Failed to get all pending notifications", zap.Error(err))
 		return err
 	}
+	// the notifications that fail stay in the store, they are sent again after
+	// pendingNotificationsInterval
 	for collectionID, notifications := range notificationMap {
 		log.Info("Sending pending notifications", zap.Any("collectionID", collectionID), zap.Any("notifications", notifications))
-		for {
-			err = n.notifer.Notify(ctx, notifications)
-			if err != nil {
-				log.Error("Failed to send pending notifications", zap.Error(err))
-			} else {
-				n.store.RemoveNotifications(ctx, notifications)
-				break
-			}
+		err = n.notifer.Notify(ctx, notifications)
+		if err != nil {
+			log.Error("Failed to send pending notifications", zap.Any("collectionID", collectionID), zap.Error(err))
+			continue
 		}
+		n.store.RemoveNotifications(ctx, notifications)
 	}
 	return nil
 }
@@ -2,6 +2,7 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
@@ -121,6 +122,60 @@ func TestSimpleNotificationProcessorWithExistingNotification(t *testing.T) {
 	cleanupDatabase(db)
 }
 
+// failingNotifier fails a number of times before sending the notifications.
+type failingNotifier struct {
+	failures int
+	sent     []model.Notification
+}
+
+func (f *failingNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("notifier unavailable")
+	}
+	f.sent = append(f.sent, notifications...)
+	return nil
+}
+
+func TestSimpleNotificationProcessorRetriesFailedNotification(t *testing.T) {
+	ctx := context.Background()
+	notificationStore := NewMemoryNotificationStore()
+	notifier := &failingNotifier{failures: 1}
+	notificationProcessor := NewSimpleNotificationProcessor(ctx, notificationStore, notifier)
+
+	notification := model.Notification{
+		CollectionID: "collection1",
+		Type:         model.NotificationTypeDeleteCollection,
+		Status:       model.NotificationStatusPending,
+	}
+	notificationStore.AddNotification(ctx, notification)
+
+	// the startup does not wait for the notifier, the notification stays pending
+	if err := notificationProcessor.Start(); err != nil {
+		t.Fatalf("Failed to start the notification processor %v", err)
+	}
+	defer notificationProcessor.Stop()
+	if len(notifier.sent) != 0 {
+		t.Errorf("Notification is sent by a failing notifier")
+	}
+	pending, _ := notificationStore.GetNotifications(ctx, notification.CollectionID)
+	if len(pending) != 1 {
+		t.Errorf("Failed notification is removed from the store")
+	}
+
+	// the next pass sends it
+	if err := notificationProcessor.sendPendingNotifications(ctx); err != nil {
+		t.Errorf("Failed to send pending notifications %v", err)
+	}
+	if len(notifier.sent) != 1 {
+		t.Errorf("Notification is not sent again")
+	}
+	pending, _ = notificationStore.GetNotifications(ctx, notification.CollectionID)
+	if len(pending) != 0 {
+		t.Errorf("Sent notification is left in the store")
+	}
+}
+
 func setupDatabase() *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
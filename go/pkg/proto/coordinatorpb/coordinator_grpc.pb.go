@@ -25,8 +25,12 @@ const _ = grpc.SupportPackageIsVersion7
 type SysDBClient interface {
 	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*CreateDatabaseResponse, error)
 	GetDatabase(ctx context.Context, in *GetDatabaseRequest, opts ...grpc.CallOption) (*GetDatabaseResponse, error)
+	DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
 	CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error)
 	DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*DeleteSegmentResponse, error)
 	GetSegments(ctx context.Context, in *GetSegmentsRequest, opts ...grpc.CallOption) (*GetSegmentsResponse, error)
@@ -35,10 +39,15 @@ type SysDBClient interface {
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	MoveCollection(ctx context.Context, in *MoveCollectionRequest, opts ...grpc.CallOption) (*MoveCollectionResponse, error)
+	ForkCollection(ctx context.Context, in *ForkCollectionRequest, opts ...grpc.CallOption) (*ForkCollectionResponse, error)
+	GetFileReferenceCounts(ctx context.Context, in *GetFileReferenceCountsRequest, opts ...grpc.CallOption) (*GetFileReferenceCountsResponse, error)
 	ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResetStateResponse, error)
 	GetLastCompactionTimeForTenant(ctx context.Context, in *GetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*GetLastCompactionTimeForTenantResponse, error)
 	SetLastCompactionTimeForTenant(ctx context.Context, in *SetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	FlushCollectionCompaction(ctx context.Context, in *FlushCollectionCompactionRequest, opts ...grpc.CallOption) (*FlushCollectionCompactionResponse, error)
+	GetCollectionVersions(ctx context.Context, in *GetCollectionVersionsRequest, opts ...grpc.CallOption) (*GetCollectionVersionsResponse, error)
+	RestoreCollectionVersion(ctx context.Context, in *RestoreCollectionVersionRequest, opts ...grpc.CallOption) (*RestoreCollectionVersionResponse, error)
 }
 
 type sysDBClient struct {
@@ -67,6 +76,24 @@ func (c *sysDBClient) GetDatabase(ctx context.Context, in *GetDatabaseRequest, o
 	return out, nil
 }
 
+func (c *sysDBClient) DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error) {
+	out := new(DeleteDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/DeleteDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error) {
 	out := new(CreateTenantResponse)
 	err := c.cc.Invoke(ctx, "/chroma.SysDB/CreateTenant", in, out, opts...)
@@ -85,6 +112,24 @@ func (c *sysDBClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts
 	return out, nil
 }
 
+func (c *sysDBClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error) {
+	out := new(DeleteTenantResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/DeleteTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error) {
+	out := new(ListTenantsResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/ListTenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error) {
 	out := new(CreateSegmentResponse)
 	err := c.cc.Invoke(ctx, "/chroma.SysDB/CreateSegment", in, out, opts...)
@@ -157,6 +202,33 @@ func (c *sysDBClient) UpdateCollection(ctx context.Context, in *UpdateCollection
 	return out, nil
 }
 
+func (c *sysDBClient) MoveCollection(ctx context.Context, in *MoveCollectionRequest, opts ...grpc.CallOption) (*MoveCollectionResponse, error) {
+	out := new(MoveCollectionResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/MoveCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ForkCollection(ctx context.Context, in *ForkCollectionRequest, opts ...grpc.CallOption) (*ForkCollectionResponse, error) {
+	out := new(ForkCollectionResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/ForkCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetFileReferenceCounts(ctx context.Context, in *GetFileReferenceCountsRequest, opts ...grpc.CallOption) (*GetFileReferenceCountsResponse, error) {
+	out := new(GetFileReferenceCountsResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/GetFileReferenceCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResetStateResponse, error) {
 	out := new(ResetStateResponse)
 	err := c.cc.Invoke(ctx, "/chroma.SysDB/ResetState", in, out, opts...)
@@ -193,14 +265,36 @@ func (c *sysDBClient) FlushCollectionCompaction(ctx context.Context, in *FlushCo
 	return out, nil
 }
 
+func (c *sysDBClient) GetCollectionVersions(ctx context.Context, in *GetCollectionVersionsRequest, opts ...grpc.CallOption) (*GetCollectionVersionsResponse, error) {
+	out := new(GetCollectionVersionsResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/GetCollectionVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) RestoreCollectionVersion(ctx context.Context, in *RestoreCollectionVersionRequest, opts ...grpc.CallOption) (*RestoreCollectionVersionResponse, error) {
+	out := new(RestoreCollectionVersionResponse)
+	err := c.cc.Invoke(ctx, "/chroma.SysDB/RestoreCollectionVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SysDBServer is the server API for SysDB service.
 // All implementations must embed UnimplementedSysDBServer
 // for forward compatibility
 type SysDBServer interface {
 	CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error)
 	GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error)
+	DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
 	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
 	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
 	CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error)
 	DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error)
 	GetSegments(context.Context, *GetSegmentsRequest) (*GetSegmentsResponse, error)
@@ -209,10 +303,15 @@ type SysDBServer interface {
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
 	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	MoveCollection(context.Context, *MoveCollectionRequest) (*MoveCollectionResponse, error)
+	ForkCollection(context.Context, *ForkCollectionRequest) (*ForkCollectionResponse, error)
+	GetFileReferenceCounts(context.Context, *GetFileReferenceCountsRequest) (*GetFileReferenceCountsResponse, error)
 	ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error)
 	GetLastCompactionTimeForTenant(context.Context, *GetLastCompactionTimeForTenantRequest) (*GetLastCompactionTimeForTenantResponse, error)
 	SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error)
 	FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error)
+	GetCollectionVersions(context.Context, *GetCollectionVersionsRequest) (*GetCollectionVersionsResponse, error)
+	RestoreCollectionVersion(context.Context, *RestoreCollectionVersionRequest) (*RestoreCollectionVersionResponse, error)
 	mustEmbedUnimplementedSysDBServer()
 }
 
@@ -226,12 +325,24 @@ func (UnimplementedSysDBServer) CreateDatabase(context.Context, *CreateDatabaseR
 func (UnimplementedSysDBServer) GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDatabase not implemented")
 }
+func (UnimplementedSysDBServer) DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDatabase not implemented")
+}
+func (UnimplementedSysDBServer) ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
 func (UnimplementedSysDBServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
 }
 func (UnimplementedSysDBServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
 }
+func (UnimplementedSysDBServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedSysDBServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
 func (UnimplementedSysDBServer) CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
 }
@@ -256,6 +367,15 @@ func (UnimplementedSysDBServer) GetCollections(context.Context, *GetCollectionsR
 func (UnimplementedSysDBServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
 }
+func (UnimplementedSysDBServer) MoveCollection(context.Context, *MoveCollectionRequest) (*MoveCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCollection not implemented")
+}
+func (UnimplementedSysDBServer) ForkCollection(context.Context, *ForkCollectionRequest) (*ForkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkCollection not implemented")
+}
+func (UnimplementedSysDBServer) GetFileReferenceCounts(context.Context, *GetFileReferenceCountsRequest) (*GetFileReferenceCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileReferenceCounts not implemented")
+}
 func (UnimplementedSysDBServer) ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetState not implemented")
 }
@@ -268,6 +388,12 @@ func (UnimplementedSysDBServer) SetLastCompactionTimeForTenant(context.Context,
 func (UnimplementedSysDBServer) FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FlushCollectionCompaction not implemented")
 }
+func (UnimplementedSysDBServer) GetCollectionVersions(context.Context, *GetCollectionVersionsRequest) (*GetCollectionVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionVersions not implemented")
+}
+func (UnimplementedSysDBServer) RestoreCollectionVersion(context.Context, *RestoreCollectionVersionRequest) (*RestoreCollectionVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCollectionVersion not implemented")
+}
 func (UnimplementedSysDBServer) mustEmbedUnimplementedSysDBServer() {}
 
 // UnsafeSysDBServer may be embedded to opt out of forward compatibility for this service.
@@ -317,6 +443,42 @@ func _SysDB_GetDatabase_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_DeleteDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/DeleteDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteDatabase(ctx, req.(*DeleteDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysDB_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTenantRequest)
 	if err := dec(in); err != nil {
@@ -353,6 +515,42 @@ func _SysDB_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/DeleteTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/ListTenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListTenants(ctx, req.(*ListTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysDB_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSegmentRequest)
 	if err := dec(in); err != nil {
@@ -497,6 +695,60 @@ func _SysDB_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_MoveCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).MoveCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/MoveCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).MoveCollection(ctx, req.(*MoveCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ForkCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ForkCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/ForkCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ForkCollection(ctx, req.(*ForkCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetFileReferenceCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileReferenceCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetFileReferenceCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/GetFileReferenceCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetFileReferenceCounts(ctx, req.(*GetFileReferenceCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SysDB_ResetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -569,6 +821,42 @@ func _SysDB_FlushCollectionCompaction_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysDB_GetCollectionVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollectionVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/GetCollectionVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollectionVersions(ctx, req.(*GetCollectionVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_RestoreCollectionVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCollectionVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RestoreCollectionVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.SysDB/RestoreCollectionVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RestoreCollectionVersion(ctx, req.(*RestoreCollectionVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SysDB_ServiceDesc is the grpc.ServiceDesc for SysDB service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -584,6 +872,14 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDatabase",
 			Handler:    _SysDB_GetDatabase_Handler,
 		},
+		{
+			MethodName: "DeleteDatabase",
+			Handler:    _SysDB_DeleteDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _SysDB_ListDatabases_Handler,
+		},
 		{
 			MethodName: "CreateTenant",
 			Handler:    _SysDB_CreateTenant_Handler,
@@ -592,6 +888,14 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTenant",
 			Handler:    _SysDB_GetTenant_Handler,
 		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _SysDB_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _SysDB_ListTenants_Handler,
+		},
 		{
 			MethodName: "CreateSegment",
 			Handler:    _SysDB_CreateSegment_Handler,
@@ -624,6 +928,18 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCollection",
 			Handler:    _SysDB_UpdateCollection_Handler,
 		},
+		{
+			MethodName: "MoveCollection",
+			Handler:    _SysDB_MoveCollection_Handler,
+		},
+		{
+			MethodName: "ForkCollection",
+			Handler:    _SysDB_ForkCollection_Handler,
+		},
+		{
+			MethodName: "GetFileReferenceCounts",
+			Handler:    _SysDB_GetFileReferenceCounts_Handler,
+		},
 		{
 			MethodName: "ResetState",
 			Handler:    _SysDB_ResetState_Handler,
@@ -640,6 +956,14 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FlushCollectionCompaction",
 			Handler:    _SysDB_FlushCollectionCompaction_Handler,
 		},
+		{
+			MethodName: "GetCollectionVersions",
+			Handler:    _SysDB_GetCollectionVersions_Handler,
+		},
+		{
+			MethodName: "RestoreCollectionVersion",
+			Handler:    _SysDB_RestoreCollectionVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chromadb/proto/coordinator.proto",
@@ -24,8 +24,14 @@ const _ = grpc.SupportPackageIsVersion7
 type LogServiceClient interface {
 	PushLogs(ctx context.Context, in *PushLogsRequest, opts ...grpc.CallOption) (*PushLogsResponse, error)
 	PullLogs(ctx context.Context, in *PullLogsRequest, opts ...grpc.CallOption) (*PullLogsResponse, error)
+	TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (LogService_TailLogsClient, error)
 	GetAllCollectionInfoToCompact(ctx context.Context, in *GetAllCollectionInfoToCompactRequest, opts ...grpc.CallOption) (*GetAllCollectionInfoToCompactResponse, error)
 	UpdateCollectionLogOffset(ctx context.Context, in *UpdateCollectionLogOffsetRequest, opts ...grpc.CallOption) (*UpdateCollectionLogOffsetResponse, error)
+	SetCollectionRetention(ctx context.Context, in *SetCollectionRetentionRequest, opts ...grpc.CallOption) (*SetCollectionRetentionResponse, error)
+	DeleteCollectionLog(ctx context.Context, in *DeleteCollectionLogRequest, opts ...grpc.CallOption) (*DeleteCollectionLogResponse, error)
+	VerifyCollectionLog(ctx context.Context, in *VerifyCollectionLogRequest, opts ...grpc.CallOption) (*VerifyCollectionLogResponse, error)
+	ExportCollectionLog(ctx context.Context, in *ExportCollectionLogRequest, opts ...grpc.CallOption) (LogService_ExportCollectionLogClient, error)
+	ImportCollectionLog(ctx context.Context, in *ImportCollectionLogRequest, opts ...grpc.CallOption) (*ImportCollectionLogResponse, error)
 }
 
 type logServiceClient struct {
@@ -54,6 +60,38 @@ func (c *logServiceClient) PullLogs(ctx context.Context, in *PullLogsRequest, op
 	return out, nil
 }
 
+func (c *logServiceClient) TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (LogService_TailLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogService_ServiceDesc.Streams[0], "/chroma.LogService/TailLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logServiceTailLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogService_TailLogsClient interface {
+	Recv() (*TailLogsResponse, error)
+	grpc.ClientStream
+}
+
+type logServiceTailLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *logServiceTailLogsClient) Recv() (*TailLogsResponse, error) {
+	m := new(TailLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *logServiceClient) GetAllCollectionInfoToCompact(ctx context.Context, in *GetAllCollectionInfoToCompactRequest, opts ...grpc.CallOption) (*GetAllCollectionInfoToCompactResponse, error) {
 	out := new(GetAllCollectionInfoToCompactResponse)
 	err := c.cc.Invoke(ctx, "/chroma.LogService/GetAllCollectionInfoToCompact", in, out, opts...)
@@ -72,14 +110,88 @@ func (c *logServiceClient) UpdateCollectionLogOffset(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *logServiceClient) SetCollectionRetention(ctx context.Context, in *SetCollectionRetentionRequest, opts ...grpc.CallOption) (*SetCollectionRetentionResponse, error) {
+	out := new(SetCollectionRetentionResponse)
+	err := c.cc.Invoke(ctx, "/chroma.LogService/SetCollectionRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) DeleteCollectionLog(ctx context.Context, in *DeleteCollectionLogRequest, opts ...grpc.CallOption) (*DeleteCollectionLogResponse, error) {
+	out := new(DeleteCollectionLogResponse)
+	err := c.cc.Invoke(ctx, "/chroma.LogService/DeleteCollectionLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) VerifyCollectionLog(ctx context.Context, in *VerifyCollectionLogRequest, opts ...grpc.CallOption) (*VerifyCollectionLogResponse, error) {
+	out := new(VerifyCollectionLogResponse)
+	err := c.cc.Invoke(ctx, "/chroma.LogService/VerifyCollectionLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) ExportCollectionLog(ctx context.Context, in *ExportCollectionLogRequest, opts ...grpc.CallOption) (LogService_ExportCollectionLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogService_ServiceDesc.Streams[1], "/chroma.LogService/ExportCollectionLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logServiceExportCollectionLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogService_ExportCollectionLogClient interface {
+	Recv() (*ExportCollectionLogResponse, error)
+	grpc.ClientStream
+}
+
+type logServiceExportCollectionLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *logServiceExportCollectionLogClient) Recv() (*ExportCollectionLogResponse, error) {
+	m := new(ExportCollectionLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logServiceClient) ImportCollectionLog(ctx context.Context, in *ImportCollectionLogRequest, opts ...grpc.CallOption) (*ImportCollectionLogResponse, error) {
+	out := new(ImportCollectionLogResponse)
+	err := c.cc.Invoke(ctx, "/chroma.LogService/ImportCollectionLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogServiceServer is the server API for LogService service.
 // All implementations must embed UnimplementedLogServiceServer
 // for forward compatibility
 type LogServiceServer interface {
 	PushLogs(context.Context, *PushLogsRequest) (*PushLogsResponse, error)
 	PullLogs(context.Context, *PullLogsRequest) (*PullLogsResponse, error)
+	TailLogs(*TailLogsRequest, LogService_TailLogsServer) error
 	GetAllCollectionInfoToCompact(context.Context, *GetAllCollectionInfoToCompactRequest) (*GetAllCollectionInfoToCompactResponse, error)
 	UpdateCollectionLogOffset(context.Context, *UpdateCollectionLogOffsetRequest) (*UpdateCollectionLogOffsetResponse, error)
+	SetCollectionRetention(context.Context, *SetCollectionRetentionRequest) (*SetCollectionRetentionResponse, error)
+	DeleteCollectionLog(context.Context, *DeleteCollectionLogRequest) (*DeleteCollectionLogResponse, error)
+	VerifyCollectionLog(context.Context, *VerifyCollectionLogRequest) (*VerifyCollectionLogResponse, error)
+	ExportCollectionLog(*ExportCollectionLogRequest, LogService_ExportCollectionLogServer) error
+	ImportCollectionLog(context.Context, *ImportCollectionLogRequest) (*ImportCollectionLogResponse, error)
 	mustEmbedUnimplementedLogServiceServer()
 }
 
@@ -93,12 +205,30 @@ func (UnimplementedLogServiceServer) PushLogs(context.Context, *PushLogsRequest)
 func (UnimplementedLogServiceServer) PullLogs(context.Context, *PullLogsRequest) (*PullLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PullLogs not implemented")
 }
+func (UnimplementedLogServiceServer) TailLogs(*TailLogsRequest, LogService_TailLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailLogs not implemented")
+}
 func (UnimplementedLogServiceServer) GetAllCollectionInfoToCompact(context.Context, *GetAllCollectionInfoToCompactRequest) (*GetAllCollectionInfoToCompactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllCollectionInfoToCompact not implemented")
 }
 func (UnimplementedLogServiceServer) UpdateCollectionLogOffset(context.Context, *UpdateCollectionLogOffsetRequest) (*UpdateCollectionLogOffsetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectionLogOffset not implemented")
 }
+func (UnimplementedLogServiceServer) SetCollectionRetention(context.Context, *SetCollectionRetentionRequest) (*SetCollectionRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionRetention not implemented")
+}
+func (UnimplementedLogServiceServer) DeleteCollectionLog(context.Context, *DeleteCollectionLogRequest) (*DeleteCollectionLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollectionLog not implemented")
+}
+func (UnimplementedLogServiceServer) VerifyCollectionLog(context.Context, *VerifyCollectionLogRequest) (*VerifyCollectionLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCollectionLog not implemented")
+}
+func (UnimplementedLogServiceServer) ExportCollectionLog(*ExportCollectionLogRequest, LogService_ExportCollectionLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCollectionLog not implemented")
+}
+func (UnimplementedLogServiceServer) ImportCollectionLog(context.Context, *ImportCollectionLogRequest) (*ImportCollectionLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCollectionLog not implemented")
+}
 func (UnimplementedLogServiceServer) mustEmbedUnimplementedLogServiceServer() {}
 
 // UnsafeLogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -148,6 +278,27 @@ func _LogService_PullLogs_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_TailLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogServiceServer).TailLogs(m, &logServiceTailLogsServer{stream})
+}
+
+type LogService_TailLogsServer interface {
+	Send(*TailLogsResponse) error
+	grpc.ServerStream
+}
+
+type logServiceTailLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *logServiceTailLogsServer) Send(m *TailLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _LogService_GetAllCollectionInfoToCompact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllCollectionInfoToCompactRequest)
 	if err := dec(in); err != nil {
@@ -184,6 +335,99 @@ func _LogService_UpdateCollectionLogOffset_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_SetCollectionRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).SetCollectionRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.LogService/SetCollectionRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).SetCollectionRetention(ctx, req.(*SetCollectionRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_DeleteCollectionLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).DeleteCollectionLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.LogService/DeleteCollectionLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).DeleteCollectionLog(ctx, req.(*DeleteCollectionLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_VerifyCollectionLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCollectionLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).VerifyCollectionLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.LogService/VerifyCollectionLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).VerifyCollectionLog(ctx, req.(*VerifyCollectionLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_ExportCollectionLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCollectionLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogServiceServer).ExportCollectionLog(m, &logServiceExportCollectionLogServer{stream})
+}
+
+type LogService_ExportCollectionLogServer interface {
+	Send(*ExportCollectionLogResponse) error
+	grpc.ServerStream
+}
+
+type logServiceExportCollectionLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *logServiceExportCollectionLogServer) Send(m *ExportCollectionLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LogService_ImportCollectionLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCollectionLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).ImportCollectionLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chroma.LogService/ImportCollectionLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).ImportCollectionLog(ctx, req.(*ImportCollectionLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LogService_ServiceDesc is the grpc.ServiceDesc for LogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,7 +451,34 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCollectionLogOffset",
 			Handler:    _LogService_UpdateCollectionLogOffset_Handler,
 		},
+		{
+			MethodName: "SetCollectionRetention",
+			Handler:    _LogService_SetCollectionRetention_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionLog",
+			Handler:    _LogService_DeleteCollectionLog_Handler,
+		},
+		{
+			MethodName: "VerifyCollectionLog",
+			Handler:    _LogService_VerifyCollectionLog_Handler,
+		},
+		{
+			MethodName: "ImportCollectionLog",
+			Handler:    _LogService_ImportCollectionLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailLogs",
+			Handler:       _LogService_TailLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportCollectionLog",
+			Handler:       _LogService_ExportCollectionLog_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chromadb/proto/logservice.proto",
 }
@@ -2,11 +2,60 @@ package libs
 
 import (
 	"context"
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/log/configuration"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
 )
 
-func NewPgConnection(ctx context.Context, config *configuration.LogServiceConfiguration) (conn *pgx.Conn, err error) {
-	conn, err = pgx.Connect(ctx, config.DATABASE_URL)
+// NewPgPool creates a connection pool for the log database. The pool replaces
+// connections that break and the health check drops idle ones that are dead, so
+// a single bad connection does not take the service down. Postgres may come up
+// after the service, so the first ping is retried.
+func NewPgPool(ctx context.Context, config *configuration.LogServiceConfiguration) (pool *pgxpool.Pool, err error) {
+	var poolConfig *pgxpool.Config
+	poolConfig, err = pgxpool.ParseConfig(config.DATABASE_URL)
+	if err != nil {
+		return
+	}
+	if config.MAX_CONNS > 0 {
+		poolConfig.MaxConns = config.MAX_CONNS
+	}
+	if config.MIN_CONNS > 0 {
+		poolConfig.MinConns = config.MIN_CONNS
+	}
+	if config.MAX_CONN_LIFETIME > 0 {
+		poolConfig.MaxConnLifetime = config.MAX_CONN_LIFETIME
+	}
+	if config.MAX_CONN_IDLE_TIME > 0 {
+		poolConfig.MaxConnIdleTime = config.MAX_CONN_IDLE_TIME
+	}
+	if config.HEALTH_CHECK_PERIOD > 0 {
+		poolConfig.HealthCheckPeriod = config.HEALTH_CHECK_PERIOD
+	}
+	pool, err = pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return
+	}
+	for attempt := 0; ; attempt++ {
+		err = pool.Ping(ctx)
+		if err == nil || attempt >= config.CONNECT_RETRIES {
+			break
+		}
+		log.Warn("failed to reach postgres, retrying", zap.Int("attempt", attempt+1), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-time.After(config.CONNECT_RETRY_INTERVAL):
+			continue
+		}
+		break
+	}
+	if err != nil {
+		pool.Close()
+		pool = nil
+	}
 	return
 }